@@ -0,0 +1,84 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// Node is a node in the syntax tree of a constraint: a *Constraint, *Group or *Comparator.
+type Node interface {
+	node()
+}
+
+// Group is a set of comparators which all have to match.
+type Group struct {
+	Comparators []*Comparator
+}
+
+func (c *Constraint) node() {}
+func (g *Group) node()      {}
+func (c *Comparator) node() {}
+
+// Groups returns the groups of the constraint, which are separated by `||` in the expression.
+// They're copies, so changing them doesn't change the constraint.
+func (c *Constraint) Groups() []*Group {
+	groups := make([]*Group, len(c.groups))
+	for k := range c.groups {
+		groups[k] = &Group{Comparators: make([]*Comparator, len(c.groups[k]))}
+		for i, comparator := range c.groups[k] {
+			copied := &Comparator{Operator: comparator.Operator}
+			if comparator.Version != nil {
+				v := *comparator.Version
+				copied.Version = &v
+			}
+			groups[k].Comparators[i] = copied
+		}
+	}
+	return groups
+}
+
+// Visitor is called by Walk for every node. When Visit returns a non-nil visitor, Walk visits
+// the children of the node with it, followed by a call with a nil node.
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk traverses the syntax tree depth-first, starting with calling v.Visit(node). A panic of the
+// visitor stops the walk with a *HookPanicError as cause.
+func Walk(v Visitor, node Node) (err error) {
+	defer recoverHook("visitor", &err)
+	walk(v, node)
+	return nil
+}
+
+func walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	switch n := node.(type) {
+	case *Constraint:
+		for _, group := range n.Groups() {
+			walk(v, group)
+		}
+	case *Group:
+		for _, comparator := range n.Comparators {
+			walk(v, comparator)
+		}
+	}
+	v.Visit(nil)
+}
+
+type inspector func(node Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses the syntax tree depth-first, calling f for every node. When f returns true
+// the children of the node are inspected as well, followed by a call with a nil node. A panic of f
+// stops the inspection with a *HookPanicError as cause.
+func Inspect(node Node, f func(node Node) bool) error {
+	return errors.Trace(Walk(inspector(f), node))
+}
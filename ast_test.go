@@ -0,0 +1,122 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestConstraintGroups(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.ParseConstraint(">=1.2.0 <2.0.0 || * || ^3.1.0-rc.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups := c.Groups()
+	if len(groups) != 3 || len(groups[0].Comparators) != 2 || len(groups[1].Comparators) != 1 {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+	if first := groups[0].Comparators[0]; first.Operator != semver.OperatorGreaterThanOrEqual ||
+		first.Version.Original() != "1.2.0" {
+		t.Fatalf("unexpected comparator `%s`", first)
+	}
+	if wildcard := groups[1].Comparators[0]; wildcard.Operator != semver.OperatorAny || wildcard.Version != nil {
+		t.Fatalf("unexpected comparator `%s`", wildcard)
+	}
+	if caret := groups[2].Comparators[0]; caret.Operator != semver.OperatorCaret || caret.Version.Prerelease != "rc.1" {
+		t.Fatalf("unexpected comparator `%s`", caret)
+	}
+
+	groups[0].Comparators[0].Operator = semver.OperatorLessThan
+	groups[0].Comparators[1].Version.Major = 9
+	if c.String() != ">=1.2.0 <2.0.0 || * || ^3.1.0-rc.1" {
+		t.Fatalf("expected changing the groups to leave the constraint alone, got `%s`", c)
+	}
+}
+
+// broadRanges reports comparators that don't bound a group from above, like `*` or a lone `>=1.0.0`.
+type broadRanges struct {
+	found   []string
+	bounded bool
+	group   []string
+}
+
+func (b *broadRanges) Visit(node semver.Node) semver.Visitor {
+	switch n := node.(type) {
+	case *semver.Group:
+		b.bounded = false
+		b.group = nil
+	case *semver.Comparator:
+		b.group = append(b.group, n.String())
+		switch n.Operator {
+		case semver.OperatorAny, semver.OperatorGreaterThan, semver.OperatorGreaterThanOrEqual, semver.OperatorNotEqual:
+		default:
+			b.bounded = true
+		}
+		return nil
+	case nil:
+		if b.group != nil && !b.bounded {
+			b.found = append(b.found, strings.Join(b.group, " "))
+		}
+		b.group = nil
+	}
+	return b
+}
+
+func TestWalk(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string]string{
+		">=1.2.0 <2.0.0":                "",
+		"*":                             "*",
+		"^1.0.0 || >=3.0.0":             ">=3.0.0",
+		">1.0.0 !=1.5.0 || * || ~2.1.0": ">1.0.0 !=1.5.0,*",
+	}
+	for expression, expected := range cases {
+		c, err := s.ParseConstraint(expression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lint := &broadRanges{}
+		semver.Walk(lint, c)
+		if found := strings.Join(lint.found, ","); found != expected {
+			t.Fatalf("expected `%s` to report `%s`, got `%s`", expression, expected, found)
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.ParseConstraint(">=1.2.0 <2.0.0 || ^3.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var visited []string
+	semver.Inspect(c, func(node semver.Node) bool {
+		switch n := node.(type) {
+		case *semver.Constraint:
+			visited = append(visited, "constraint")
+		case *semver.Group:
+			visited = append(visited, "group")
+			return len(n.Comparators) > 1
+		case *semver.Comparator:
+			visited = append(visited, n.String())
+			return false
+		case nil:
+			visited = append(visited, "end")
+		}
+		return true
+	})
+	if strings.Join(visited, " ") != "constraint group >=1.2.0 <2.0.0 end group end" {
+		t.Fatalf("unexpected traversal `%s`", strings.Join(visited, " "))
+	}
+}
@@ -0,0 +1,19 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// AtLeast validates the version and checks if it's greater than or equal to major.minor.patch, for
+// feature gates like "requires server >= 2.5.0". It compares by precedence, so `2.5.0-rc.1` isn't at
+// least 2.5.0.
+func (s *Semver) AtLeast(version string, major int, minor int, patch int) (bool, error) {
+	if major < 0 || minor < 0 || patch < 0 {
+		return false, errors.Errorf("%d.%d.%d has a negative part", major, minor, patch)
+	}
+	v, err := s.Parse(version)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return v.Compare(&Version{Major: major, Minor: minor, Patch: patch}) >= 0, nil
+}
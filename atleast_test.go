@@ -0,0 +1,49 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestAtLeast(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		version string
+		major   int
+		minor   int
+		patch   int
+		atLeast bool
+	}{
+		{"2.5.0", 2, 5, 0, true},
+		{"2.5.1", 2, 5, 0, true},
+		{"3.0.0", 2, 5, 0, true},
+		{"2.5.0+build.1", 2, 5, 0, true},
+		{"2.4.9", 2, 5, 0, false},
+		{"2.5.0-rc.1", 2, 5, 0, false},
+		{"2.5.1-rc.1", 2, 5, 0, true},
+		{"1.9.9", 2, 0, 0, false},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version, func(t2 *testing.T) {
+			atLeast, err := s.AtLeast(c.version, c.major, c.minor, c.patch)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if atLeast != c.atLeast {
+				t2.Fatalf("expected %t, got %t", c.atLeast, atLeast)
+			}
+		})
+	}
+}
+
+func TestAtLeastErrors(t *testing.T) {
+	s := semver.MustNew()
+	if _, err := s.AtLeast("2.5", 2, 5, 0); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := s.AtLeast("2.5.0", 2, -1, 0); err == nil {
+		t.Fatal("expected an error for a negative part")
+	}
+}
@@ -0,0 +1,75 @@
+package semver
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// compareAllChunk is the number of pairs a worker compares before taking the next chunk.
+const compareAllChunk = 256
+
+// CompareAll compares every pair like Compare does, spread over a worker per CPU. The results are in
+// the order of the pairs. An invalid pair stops the batch, which fails with the error of the invalid
+// pair with the lowest index, and so does cancelling the context, with the context's error as cause.
+func (s *Semver) CompareAll(ctx context.Context, pairs [][2]string) ([]int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make([]int, len(pairs))
+	chunks := make(chan int)
+	// The chunks are fed in order and a worker finishes its chunk unless a pair fails, so every pair
+	// before a failed one is compared and the lowest failed index is the same on every run.
+	var mu sync.Mutex
+	failed := -1
+	var failedErr error
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for start := range chunks {
+				end := start + compareAllChunk
+				if end > len(pairs) {
+					end = len(pairs)
+				}
+				for k := start; k < end; k++ {
+					c, err := s.compare(pairs[k][0], pairs[k][1])
+					if err != nil {
+						mu.Lock()
+						if failed < 0 || k < failed {
+							failed, failedErr = k, errors.Annotatef(err, "pair %d", k)
+						}
+						mu.Unlock()
+						cancel()
+						return
+					}
+					results[k] = c
+				}
+			}
+		}()
+	}
+	var err error
+feed:
+	for start := 0; start < len(pairs); start += compareAllChunk {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case chunks <- start:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break feed
+		}
+	}
+	close(chunks)
+	wg.Wait()
+	if failedErr != nil {
+		return nil, failedErr
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results, nil
+}
@@ -0,0 +1,65 @@
+package semver_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func comparePairs(n int) [][2]string {
+	pairs := make([][2]string, n)
+	for k := range pairs {
+		pairs[k] = [2]string{"1." + strconv.Itoa(k%7) + ".0", "1." + strconv.Itoa(k%5) + ".0-rc." + strconv.Itoa(k%3)}
+	}
+	return pairs
+}
+
+func TestCompareAll(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{0, 1, 255, 256, 257, 5000} {
+		pairs := comparePairs(n)
+		results, err := s.CompareAll(context.Background(), pairs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(results) != n {
+			t.Fatalf("expected %d results, got %d", n, len(results))
+		}
+		for k := range pairs {
+			if expected := semver.CompareStrings(pairs[k][0], pairs[k][1]); results[k] != expected {
+				t.Fatalf("expected %d for `%s` and `%s`, got %d", expected, pairs[k][0], pairs[k][1], results[k])
+			}
+		}
+	}
+}
+
+func TestCompareAllErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs := comparePairs(2000)
+	pairs[1234][1] = invalidVersions[0]
+	if _, err := s.CompareAll(context.Background(), pairs); err == nil {
+		t.Fatal("expected an error for an invalid pair")
+	}
+	pairs[1999][0] = invalidVersions[0]
+	pairs[300][0] = invalidVersions[0]
+	for run := 0; run < 20; run++ {
+		if _, err := s.CompareAll(context.Background(), pairs); err == nil || !strings.HasPrefix(err.Error(), "pair 300:") {
+			t.Fatalf("expected the error of pair 300, got %v", err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := s.CompareAll(ctx, comparePairs(10)); errors.Cause(err) != context.Canceled {
+		t.Fatalf("expected the context to be canceled, got %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package semver_test
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+// assertAllocations fails when f allocates more than max times on average, so regressions in the hot
+// paths show up in the regular test run instead of only in benchmarks.
+func assertAllocations(t *testing.T, max float64, f func()) {
+	t.Helper()
+	if allocations := testing.AllocsPerRun(100, f); allocations > max {
+		t.Fatalf("expected at most %.0f allocations, got %.1f", max, allocations)
+	}
+}
+
+func TestAllocations(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := s.Parse("1.20.3-rc.1+build.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := s.Parse("1.20.3-rc.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	constraint, err := s.ParseConstraint(">=1.2.0 <2.0.0 || ^3.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := s.Parse("3.4.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("Valid", func(t2 *testing.T) {
+		assertAllocations(t2, 1, func() {
+			s.Valid("1.20.3-rc.1+build.5")
+		})
+	})
+	t.Run("Version.Compare", func(t2 *testing.T) {
+		assertAllocations(t2, 0, func() {
+			a.Compare(b)
+		})
+	})
+	t.Run("Constraint.CheckVersion", func(t2 *testing.T) {
+		assertAllocations(t2, 1, func() {
+			constraint.CheckVersion(v)
+		})
+	})
+}
+
+func BenchmarkValid(b *testing.B) {
+	s, err := semver.New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Valid("1.20.3-rc.1+build.5")
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	s, err := semver.New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := s.Compare("1.20.3-rc.1+build.5", "1.20.3-rc.2"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkConstraintCheck(b *testing.B) {
+	s, err := semver.New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	constraint, err := s.ParseConstraint(">=1.2.0 <2.0.0 || ^3.1.0")
+	if err != nil {
+		b.Fatal(err)
+	}
+	v, err := s.Parse("3.4.1")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		constraint.CheckVersion(v)
+	}
+}
+
+func BenchmarkSort10k(b *testing.B) {
+	s, err := semver.New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	random := rand.New(rand.NewSource(1))
+	versions := make([]*semver.Version, 10000)
+	for k := range versions {
+		version := strconv.Itoa(random.Intn(10)) + "." + strconv.Itoa(random.Intn(50)) + "." + strconv.Itoa(random.Intn(100))
+		if random.Intn(4) == 0 {
+			version += "-rc." + strconv.Itoa(random.Intn(5))
+		}
+		versions[k], err = s.Parse(version)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	sorted := make([]*semver.Version, len(versions))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(sorted, versions)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].Compare(sorted[j]) < 0
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/espal-digital-development/semver/core"
+	"github.com/juju/errors"
+)
+
+// The first byte of the binary encoding, which orders the Max sentinel after the parsed versions.
+const (
+	binaryVersion byte = 0x01
+	binaryMax     byte = 0x02
+)
+
+// The bytes of the prerelease in the binary encoding. A release sorts after any of its prereleases and
+// numeric identifiers sort before alphanumeric ones.
+const (
+	binaryEnd          byte = 0x00
+	binaryNumeric      byte = 0x01
+	binaryAlphanumeric byte = 0x02
+	binaryRelease      byte = 0xff
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler with a compact encoding of which the byte order is
+// the precedence order, so the bytes can be used as keys of a sorted key-value store. Numbers are stored
+// as their length followed by their big-endian bytes and the build metadata is appended as is, so it
+// only orders versions of equal precedence. Custom channel orders aren't part of the encoding.
+func (v Version) MarshalBinary() ([]byte, error) {
+	if v.sentinel == sentinelMax {
+		return []byte{binaryMax}, nil
+	}
+	if v.Epoch < 0 || v.Major < 0 || v.Minor < 0 || v.Patch < 0 || v.Revision < 0 {
+		return nil, errors.Errorf("version `%s` has a negative part", v.canonical())
+	}
+	b := make([]byte, 0, 16+len(v.Prerelease)+len(v.Build))
+	b = append(b, binaryVersion)
+	for _, n := range []int{v.Epoch, v.Major, v.Minor, v.Patch, v.Revision} {
+		b = appendBinaryUint(b, uint64(n))
+	}
+	if v.Prerelease == "" {
+		b = append(b, binaryRelease)
+	} else {
+		for _, identifier := range strings.Split(v.Prerelease, ".") {
+			if isNumeric(identifier) {
+				// Without leading zeros, longer numbers are bigger, so the length goes first.
+				b = append(b, binaryNumeric)
+				b = appendBinaryUint(b, uint64(len(identifier)))
+				b = append(b, identifier...)
+				continue
+			}
+			b = append(b, binaryAlphanumeric)
+			b = append(b, identifier...)
+			b = append(b, binaryEnd)
+		}
+		b = append(b, binaryEnd)
+	}
+	return append(b, v.Build...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the encoding of MarshalBinary.
+func (v *Version) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("binary version is empty")
+	}
+	switch data[0] {
+	case binaryMax:
+		*v = Max()
+		return nil
+	case binaryVersion:
+	default:
+		return errors.Errorf("binary version has unknown kind %d", data[0])
+	}
+	data = data[1:]
+	var parts [5]int
+	for k := range parts {
+		n, rest, err := readBinaryUint(data)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if n > uint64(maxInt) {
+			return errors.New("binary version has a number too big to fit in an int")
+		}
+		parts[k] = int(n)
+		data = rest
+	}
+	prerelease, build, err := readBinaryPrerelease(data)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	check := "0.0.0"
+	if prerelease != "" {
+		check += "-" + prerelease
+	}
+	if build != "" {
+		check += "+" + build
+	}
+	if !core.Valid(check) {
+		return errors.Errorf("binary version has invalid prerelease `%s` or build metadata `%s`", prerelease, build)
+	}
+	*v = Version{
+		Epoch:      parts[0],
+		Major:      parts[1],
+		Minor:      parts[2],
+		Patch:      parts[3],
+		Revision:   parts[4],
+		Prerelease: prerelease,
+		Build:      build,
+	}
+	return nil
+}
+
+func readBinaryPrerelease(data []byte) (string, string, error) {
+	if len(data) == 0 {
+		return "", "", errors.New("binary version is truncated")
+	}
+	if data[0] == binaryRelease {
+		return "", string(data[1:]), nil
+	}
+	var identifiers []string
+	for {
+		if len(data) == 0 {
+			return "", "", errors.New("binary version is truncated")
+		}
+		kind := data[0]
+		data = data[1:]
+		switch kind {
+		case binaryEnd:
+			if len(identifiers) == 0 {
+				return "", "", errors.New("binary version has an empty prerelease")
+			}
+			return strings.Join(identifiers, "."), string(data), nil
+		case binaryNumeric:
+			n, rest, err := readBinaryUint(data)
+			if err != nil {
+				return "", "", errors.Trace(err)
+			}
+			if n == 0 || n > uint64(len(rest)) {
+				return "", "", errors.New("binary version is truncated")
+			}
+			identifier := string(rest[:n])
+			if !validNumericIdentifier(identifier) {
+				return "", "", errors.Errorf("binary version has invalid numeric identifier `%s`", identifier)
+			}
+			identifiers = append(identifiers, identifier)
+			data = rest[n:]
+		case binaryAlphanumeric:
+			end := -1
+			for k := range data {
+				if data[k] == binaryEnd {
+					end = k
+					break
+				}
+			}
+			if end < 0 {
+				return "", "", errors.New("binary version is truncated")
+			}
+			identifier := string(data[:end])
+			if isNumeric(identifier) {
+				return "", "", errors.Errorf("binary version has numeric identifier `%s` stored as alphanumeric", identifier)
+			}
+			identifiers = append(identifiers, identifier)
+			data = data[end+1:]
+		default:
+			return "", "", errors.Errorf("binary version has unknown identifier kind %d", kind)
+		}
+	}
+}
+
+// appendBinaryUint appends the number as its byte length followed by its big-endian bytes without
+// leading zeros, which orders the same as the numbers themselves.
+func appendBinaryUint(b []byte, n uint64) []byte {
+	var digits [8]byte
+	length := 0
+	for ; n > 0; n >>= 8 {
+		length++
+		digits[8-length] = byte(n)
+	}
+	b = append(b, byte(length))
+	return append(b, digits[8-length:]...)
+}
+
+func readBinaryUint(data []byte) (uint64, []byte, error) {
+	if len(data) == 0 {
+		return 0, nil, errors.New("binary version is truncated")
+	}
+	length := int(data[0])
+	if length > 8 {
+		return 0, nil, errors.Errorf("binary version has a number of %d bytes", length)
+	}
+	if len(data) < 1+length {
+		return 0, nil, errors.New("binary version is truncated")
+	}
+	if length > 0 && data[1] == 0 {
+		return 0, nil, errors.New("binary version has a number with a leading zero byte")
+	}
+	var n uint64
+	for _, digit := range data[1 : 1+length] {
+		n = n<<8 | uint64(digit)
+	}
+	return n, data[1+length:], nil
+}
@@ -0,0 +1,130 @@
+package semver_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestBinary(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch(), semver.WithQuadSegments())
+	// Ordered by precedence, like the example in the semver 2.0.0 spec.
+	versions := []string{
+		"0.0.0",
+		"0.0.1",
+		"0.9.0",
+		"1.0.0-0",
+		"1.0.0-1",
+		"1.0.0-2",
+		"1.0.0-10",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.0.1",
+		"1.0.255",
+		"1.0.256",
+		"1.2.3",
+		"300.0.0",
+		"1:0.0.0",
+	}
+	var previous []byte
+	for k := range versions {
+		v, err := s.Parse(versions[k])
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if previous != nil && bytes.Compare(previous, b) >= 0 {
+			t.Fatalf("expected `%s` to sort after `%s`", versions[k], versions[k-1])
+		}
+		previous = b
+		decoded := &semver.Version{}
+		if err := decoded.UnmarshalBinary(b); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Compare(v) != 0 || decoded.Prerelease != v.Prerelease {
+			t.Fatalf("expected `%s`, got `%s`", versions[k], decoded.Original())
+		}
+	}
+}
+
+func TestBinaryBuild(t *testing.T) {
+	s := semver.MustNew()
+	v, err := s.Parse("1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &semver.Version{}
+	if err := decoded.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Original() != "1.2.3-rc.1+build.5" {
+		t.Fatalf("expected `1.2.3-rc.1+build.5`, got `%s`", decoded.Original())
+	}
+}
+
+func TestBinarySentinels(t *testing.T) {
+	zero, err := semver.MustParse("0.0.0-0").MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	max, err := semver.Max().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := semver.Zero().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Compare(zero, v) >= 0 || bytes.Compare(v, max) >= 0 {
+		t.Fatal("expected Zero to sort after its prereleases and Max last")
+	}
+	decoded := &semver.Version{}
+	if err := decoded.UnmarshalBinary(max); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.IsUnbounded() {
+		t.Fatal("expected the Max sentinel")
+	}
+}
+
+func TestBinaryErrors(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", []byte{}},
+		{"kind", []byte{0x03}},
+		{"truncated core", []byte{0x01, 0x01}},
+		{"number length", []byte{0x01, 0x09}},
+		{"leading zero byte", []byte{0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff}},
+		{"no prerelease", []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{"empty prerelease", []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{"unterminated identifier", []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 'r', 'c'}},
+		{"numeric as alphanumeric", []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, '1', 0x00, 0x00}},
+		{"leading zero identifier", []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0x02, '0', '1', 0x00}},
+		{"invalid build", []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, '+'}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			v := &semver.Version{}
+			if err := v.UnmarshalBinary(c.data); err == nil {
+				t2.Fatalf("expected an error, got `%s`", v.Original())
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package semver
+
+// Bounds returns the lowest and highest versions the constraint allows, for displaying summaries like
+// `>=1.2.0 <2.0.0` and quick interval math. A nil bound leaves that side open. With multiple groups the
+// bounds span all of them, so `^1.2.0 || ^3.0.0` has `>=1.2.0 <4.0.0-0`, and exclusions like `!=1.5.0`
+// are left out. Exact is set when the constraint allows a single version only. Constraints that can't
+// be satisfied have no bounds, like `*`; use Simplify to tell them apart.
+func (c *Constraint) Bounds() (min *Version, minInclusive bool, max *Version, maxInclusive bool, exact bool) {
+	var lower, upper *bound
+	var openLower, openUpper, satisfiable bool
+	for k := range c.groups {
+		group, ok := simplifyGroup(c.groups[k])
+		if !ok {
+			continue
+		}
+		satisfiable = true
+		groupLower, groupUpper, _, _ := groupBounds(group)
+		if groupLower == nil {
+			openLower = true
+		} else {
+			lower = loosenLower(lower, groupLower)
+		}
+		if groupUpper == nil {
+			openUpper = true
+		} else {
+			upper = loosenUpper(upper, groupUpper)
+		}
+	}
+	if !satisfiable {
+		return nil, false, nil, false, false
+	}
+	if lower != nil && !openLower {
+		min, minInclusive = lower.version, lower.inclusive
+	}
+	if upper != nil && !openUpper {
+		max, maxInclusive = upper.version, upper.inclusive
+	}
+	exact = min != nil && max != nil && minInclusive && maxInclusive && min.Compare(max) == 0
+	return min, minInclusive, max, maxInclusive, exact
+}
+
+func loosenLower(current *bound, candidate *bound) *bound {
+	if current == nil {
+		return candidate
+	}
+	c := candidate.version.Compare(current.version)
+	if c < 0 || (c == 0 && candidate.inclusive) {
+		return candidate
+	}
+	return current
+}
+
+func loosenUpper(current *bound, candidate *bound) *bound {
+	if current == nil {
+		return candidate
+	}
+	c := candidate.version.Compare(current.version)
+	if c > 0 || (c == 0 && candidate.inclusive) {
+		return candidate
+	}
+	return current
+}
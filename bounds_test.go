@@ -0,0 +1,61 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestConstraintBounds(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		expression   string
+		min          string
+		minInclusive bool
+		max          string
+		maxInclusive bool
+		exact        bool
+	}{
+		{">=1.2.0 <2.0.0", "1.2.0", true, "2.0.0", false, false},
+		{"^1.2.0", "1.2.0", true, "2.0.0-0", false, false},
+		{"~0.3.1", "0.3.1", true, "0.4.0-0", false, false},
+		{">1.0.0 >=1.2.0 <=3.0.0 <4.0.0", "1.2.0", true, "3.0.0", true, false},
+		{"^1.2.0 || ^3.0.0", "1.2.0", true, "4.0.0-0", false, false},
+		{">1.0.0 || >=1.0.0 <2.0.0", "1.0.0", true, "", false, false},
+		{"<2.0.0 || >=3.0.0 <4.0.0", "", false, "4.0.0", false, false},
+		{"1.2.3", "1.2.3", true, "1.2.3", true, true},
+		{">=1.2.3 <=1.2.3 !=1.5.0", "1.2.3", true, "1.2.3", true, true},
+		{">=1.0.0 !=1.5.0", "1.0.0", true, "", false, false},
+		{">2.0.0 <1.0.0 || ^1.4.0", "1.4.0", true, "2.0.0-0", false, false},
+		{"*", "", false, "", false, false},
+		{"1.2.3 !=1.2.3", "", false, "", false, false},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.expression, func(t2 *testing.T) {
+			constraint, err := s.ParseConstraint(c.expression)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			min, minInclusive, max, maxInclusive, exact := constraint.Bounds()
+			if versionString(min) != c.min || minInclusive != c.minInclusive {
+				t2.Fatalf("expected min `%s` inclusive %t, got `%s` %t", c.min, c.minInclusive, versionString(min),
+					minInclusive)
+			}
+			if versionString(max) != c.max || maxInclusive != c.maxInclusive {
+				t2.Fatalf("expected max `%s` inclusive %t, got `%s` %t", c.max, c.maxInclusive, versionString(max),
+					maxInclusive)
+			}
+			if exact != c.exact {
+				t2.Fatalf("expected exact %t, got %t", c.exact, exact)
+			}
+		})
+	}
+}
+
+func versionString(v *semver.Version) string {
+	if v == nil {
+		return ""
+	}
+	return v.Original()
+}
@@ -0,0 +1,43 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// WithBuildMetadata sets the build metadata of the version, replacing any it already has, so
+// `1.2.3-rc.1` with `sha.5114f85` becomes `1.2.3-rc.1+sha.5114f85`. The metadata has to consist of
+// dot-separated identifiers of only ASCII alphanumerics and hyphens.
+func (s *Semver) WithBuildMetadata(version string, meta string) (string, error) {
+	if !s.Valid(version) {
+		return "", errors.Errorf("version `%s` is invalid", version)
+	}
+	if !ValidBuildMetadata(meta) {
+		return "", errors.Errorf("build metadata `%s` is invalid", meta)
+	}
+	return stripBuild(version) + "+" + meta, nil
+}
+
+// StripBuildMetadata removes the build metadata from the version, so `1.2.3+sha.5114f85` becomes `1.2.3`.
+func (s *Semver) StripBuildMetadata(version string) (string, error) {
+	if !s.Valid(version) {
+		return "", errors.Errorf("version `%s` is invalid", version)
+	}
+	return stripBuild(version), nil
+}
+
+// ValidBuildMetadata checks if the metadata can be used as the build metadata of a version,
+// without the leading `+`.
+func ValidBuildMetadata(meta string) bool {
+	for _, identifier := range strings.Split(meta, ".") {
+		if !ValidBuildIdentifier(identifier) {
+			return false
+		}
+	}
+	return true
+}
+
+func isIdentifierChar(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-'
+}
@@ -0,0 +1,78 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var buildMetadataVersions = []struct {
+	version  string
+	meta     string
+	expected string
+}{
+	{"1.2.3", "sha.5114f85", "1.2.3+sha.5114f85"},
+	{"1.2.3-rc.1", "build.42", "1.2.3-rc.1+build.42"},
+	{"1.2.3+old", "new", "1.2.3+new"},
+	{"1.2.3", "001-x", "1.2.3+001-x"},
+}
+
+func TestWithBuildMetadata(t *testing.T) {
+	for k := range buildMetadataVersions {
+		b := buildMetadataVersions[k]
+		t.Run("with-build-metadata-"+b.version+"_"+b.meta, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			stamped, err := semver.WithBuildMetadata(b.version, b.meta)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if stamped != b.expected {
+				t2.Fatalf("expected `%s`, got `%s`", b.expected, stamped)
+			}
+			if !semver.Valid(stamped) {
+				t2.Fatalf("expected `%s` to be valid", stamped)
+			}
+		})
+	}
+}
+
+func TestStripBuildMetadata(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for version, expected := range map[string]string{
+		"1.2.3+sha.5114f85":  "1.2.3",
+		"1.2.3-rc.1+build.5": "1.2.3-rc.1",
+		"1.2.3":              "1.2.3",
+	} {
+		stripped, err := semver.StripBuildMetadata(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stripped != expected {
+			t.Fatalf("expected `%s` to strip to `%s`, got `%s`", version, expected, stripped)
+		}
+	}
+}
+
+func TestBuildMetadataErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.WithBuildMetadata(invalidVersions[0], "sha.5114f85"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := semver.StripBuildMetadata(invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	for _, meta := range []string{"", ".", "sha.", ".sha", "sha..1", "feature/x", "a_b", "ünïcode", "a+b"} {
+		if _, err := semver.WithBuildMetadata("1.2.3", meta); err == nil {
+			t.Fatalf("expected an error for build metadata `%s`", meta)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Builder constructs versions part by part, validating every part as it's set:
+//
+//	semver.Build().Major(1).Minor(2).Patch(3).Prerelease("rc", "1").Meta("sha.abc").String()
+//
+// The error of the first invalid part is kept and reported by Err and Version.
+type Builder struct {
+	version Version
+	err     error
+}
+
+// Build starts building a `0.0.0` version.
+func Build() *Builder {
+	return &Builder{}
+}
+
+// Major sets the major.
+func (b *Builder) Major(major int) *Builder {
+	if b.err == nil && major < 0 {
+		b.err = errors.Errorf("major `%d` is negative", major)
+	}
+	b.version.Major = major
+	return b
+}
+
+// Minor sets the minor.
+func (b *Builder) Minor(minor int) *Builder {
+	if b.err == nil && minor < 0 {
+		b.err = errors.Errorf("minor `%d` is negative", minor)
+	}
+	b.version.Minor = minor
+	return b
+}
+
+// Patch sets the patch.
+func (b *Builder) Patch(patch int) *Builder {
+	if b.err == nil && patch < 0 {
+		b.err = errors.Errorf("patch `%d` is negative", patch)
+	}
+	b.version.Patch = patch
+	return b
+}
+
+// Prerelease sets the prerelease to the identifiers joined by dots, replacing any set before.
+// Calling it without identifiers clears the prerelease.
+func (b *Builder) Prerelease(identifiers ...string) *Builder {
+	prerelease := strings.Join(identifiers, ".")
+	if b.err == nil && prerelease != "" && !ValidPrerelease(prerelease) {
+		b.err = errors.Errorf("prerelease `%s` is invalid", prerelease)
+	}
+	b.version.Prerelease = prerelease
+	return b
+}
+
+// Meta sets the build metadata to the identifiers joined by dots, replacing any set before.
+// Calling it without identifiers clears the build metadata.
+func (b *Builder) Meta(identifiers ...string) *Builder {
+	meta := strings.Join(identifiers, ".")
+	if b.err == nil && meta != "" && !ValidBuildMetadata(meta) {
+		b.err = errors.Errorf("build metadata `%s` is invalid", meta)
+	}
+	b.version.Build = meta
+	return b
+}
+
+// Err returns the error of the first invalid part, if any.
+func (b *Builder) Err() error {
+	return b.err
+}
+
+// Version returns the built version, or the error of the first invalid part.
+func (b *Builder) Version() (*Version, error) {
+	if b.err != nil {
+		return nil, errors.Trace(b.err)
+	}
+	v := b.version
+	v.original = v.canonical()
+	return &v, nil
+}
+
+// String returns the built version, which is always valid. When a part was invalid it returns
+// an empty string; use Version or Err to get the error.
+func (b *Builder) String() string {
+	if b.err != nil {
+		return ""
+	}
+	return b.version.canonical()
+}
@@ -0,0 +1,77 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestBuilder(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		builder  *semver.Builder
+		expected string
+	}{
+		{semver.Build(), "0.0.0"},
+		{semver.Build().Major(1).Minor(2).Patch(3), "1.2.3"},
+		{semver.Build().Major(1).Minor(2).Patch(3).Prerelease("rc", "1").Meta("sha.abc"), "1.2.3-rc.1+sha.abc"},
+		{semver.Build().Major(2).Prerelease("alpha.1"), "2.0.0-alpha.1"},
+		{semver.Build().Patch(7).Meta("build", "001"), "0.0.7+build.001"},
+		{semver.Build().Major(1).Prerelease("rc").Prerelease(), "1.0.0"},
+		{semver.Build().Major(1).Meta("a").Meta("b"), "1.0.0+b"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run("builder-"+c.expected, func(t2 *testing.T) {
+			if c.builder.Err() != nil {
+				t2.Fatal(c.builder.Err())
+			}
+			if c.builder.String() != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, c.builder.String())
+			}
+			if !s.Valid(c.builder.String()) {
+				t2.Fatalf("expected `%s` to be valid", c.builder.String())
+			}
+			v, err := c.builder.Version()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			parsed, err := s.Parse(c.expected)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if v.Original() != c.expected || v.Compare(parsed) != 0 || v.Build != parsed.Build {
+				t2.Fatalf("expected version `%s`, got `%s`", c.expected, v.Original())
+			}
+		})
+	}
+}
+
+func TestBuilderErrors(t *testing.T) {
+	cases := []*semver.Builder{
+		semver.Build().Major(-1),
+		semver.Build().Minor(-1),
+		semver.Build().Patch(-1),
+		semver.Build().Prerelease("rc", "01"),
+		semver.Build().Prerelease("rc", ""),
+		semver.Build().Prerelease("rc_1"),
+		semver.Build().Meta("sha", ""),
+		semver.Build().Meta("feature/x"),
+		semver.Build().Major(-1).Minor(2).Prerelease("rc"),
+	}
+	for k := range cases {
+		b := cases[k]
+		if b.Err() == nil {
+			t.Fatalf("expected case %d to have an error", k)
+		}
+		if b.String() != "" {
+			t.Fatalf("expected case %d to render empty, got `%s`", k, b.String())
+		}
+		if _, err := b.Version(); err == nil {
+			t.Fatalf("expected case %d to fail building", k)
+		}
+	}
+}
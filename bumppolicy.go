@@ -0,0 +1,64 @@
+package semver
+
+// Reason tells why a BumpPolicy doesn't allow a bump. It's empty when the bump is allowed.
+type Reason string
+
+// The reasons a BumpPolicy doesn't allow a bump.
+const (
+	ReasonNone       Reason = ""
+	ReasonInvalid    Reason = "a version is invalid"
+	ReasonNotHigher  Reason = "the next version is not higher"
+	ReasonTooBig     Reason = "the bump is bigger than allowed without approval"
+	ReasonSkipped    Reason = "the next version skips a release"
+	ReasonPrerelease Reason = "prereleases are not allowed"
+)
+
+// BumpPolicy declares which bumps are allowed, like only patch bumps on release branches, or no major
+// bumps without approval. Changing the epoch counts as a major bump.
+type BumpPolicy struct {
+	semver *Semver
+	// MaxBump is the biggest bump that's allowed without approval.
+	MaxBump Bump
+	// Approved allows bumps bigger than MaxBump, like when the pipeline has an approval flag set.
+	Approved bool
+	// NoSkips only allows the immediate successors, see Semver.IsImmediateSuccessor.
+	NoSkips bool
+	// NoPrereleases doesn't allow bumping to a prerelease.
+	NoPrereleases bool
+}
+
+// NewBumpPolicy returns a BumpPolicy that allows bumps up to maxBump.
+func NewBumpPolicy(semver *Semver, maxBump Bump) *BumpPolicy {
+	return &BumpPolicy{semver: semver, MaxBump: maxBump}
+}
+
+// Allowed checks if the policy allows going from prev to next. When it doesn't, the reason tells why.
+func (p *BumpPolicy) Allowed(prev string, next string) (bool, Reason) {
+	previous, err := p.semver.Parse(prev)
+	if err != nil {
+		return false, ReasonInvalid
+	}
+	v, err := p.semver.Parse(next)
+	if err != nil {
+		return false, ReasonInvalid
+	}
+	if v.Compare(previous) <= 0 {
+		return false, ReasonNotHigher
+	}
+	if p.NoPrereleases && v.Prerelease != "" {
+		return false, ReasonPrerelease
+	}
+	bump := bumpBetween(previous, v)
+	if previous.Epoch != v.Epoch {
+		bump = BumpMajor
+	}
+	if bump > p.MaxBump && !p.Approved {
+		return false, ReasonTooBig
+	}
+	if p.NoSkips {
+		if successor, err := p.semver.IsImmediateSuccessor(prev, next); err != nil || !successor {
+			return false, ReasonSkipped
+		}
+	}
+	return true, ReasonNone
+}
@@ -0,0 +1,49 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestBumpPolicy(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch())
+	releaseBranch := semver.NewBumpPolicy(s, semver.BumpPatch)
+	releaseBranch.NoSkips = true
+	releaseBranch.NoPrereleases = true
+	main := semver.NewBumpPolicy(s, semver.BumpMinor)
+	approved := semver.NewBumpPolicy(s, semver.BumpMinor)
+	approved.Approved = true
+	cases := []struct {
+		name    string
+		policy  *semver.BumpPolicy
+		prev    string
+		next    string
+		allowed bool
+		reason  semver.Reason
+	}{
+		{"patch on release branch", releaseBranch, "1.4.2", "1.4.3", true, semver.ReasonNone},
+		{"minor on release branch", releaseBranch, "1.4.2", "1.5.0", false, semver.ReasonTooBig},
+		{"skipped patch", releaseBranch, "1.4.2", "1.4.4", false, semver.ReasonSkipped},
+		{"prerelease on release branch", releaseBranch, "1.4.2", "1.4.3-rc.1", false, semver.ReasonPrerelease},
+		{"promotion on release branch", releaseBranch, "1.4.3-rc.1", "1.4.3", true, semver.ReasonNone},
+		{"minor on main", main, "1.4.2", "1.6.0", true, semver.ReasonNone},
+		{"prerelease on main", main, "1.4.2", "1.5.0-rc.1", true, semver.ReasonNone},
+		{"major without approval", main, "1.4.2", "2.0.0", false, semver.ReasonTooBig},
+		{"epoch without approval", main, "1.4.2", "1:1.4.3", false, semver.ReasonTooBig},
+		{"major with approval", approved, "1.4.2", "2.0.0", true, semver.ReasonNone},
+		{"downgrade", approved, "1.4.2", "1.4.1", false, semver.ReasonNotHigher},
+		{"same", main, "1.4.2", "1.4.2+build.5", false, semver.ReasonNotHigher},
+		{"invalid", main, "1.4", "1.4.3", false, semver.ReasonInvalid},
+		{"invalid next", main, "1.4.2", "1.5", false, semver.ReasonInvalid},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			allowed, reason := c.policy.Allowed(c.prev, c.next)
+			if allowed != c.allowed || reason != c.reason {
+				t2.Fatalf("expected %t `%s`, got %t `%s`", c.allowed, c.reason, allowed, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package semver
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// WithConstraintCache caches up to size parsed constraints by their expression, evicting the least
+// recently used one when it's full, so services checking the same constraints over and over don't
+// parse them every time. Lookups are reported to Metrics as the `constraint` cache.
+func WithConstraintCache(size int) Option {
+	return func(s *Semver) error {
+		if size <= 0 {
+			return errors.Errorf("constraint cache size %d should be positive", size)
+		}
+		s.constraints = &constraintCache{
+			size:    size,
+			order:   list.New(),
+			entries: map[string]*list.Element{},
+		}
+		return nil
+	}
+}
+
+// constraintCache is an LRU cache of parsed constraints. It's safe for concurrent use.
+type constraintCache struct {
+	mutex   sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type constraintCacheEntry struct {
+	expression string
+	constraint *Constraint
+}
+
+func (c *constraintCache) get(expression string) (*Constraint, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	element, ok := c.entries[expression]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*constraintCacheEntry).constraint, true
+}
+
+func (c *constraintCache) add(expression string, constraint *Constraint) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if element, ok := c.entries[expression]; ok {
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[expression] = c.order.PushFront(&constraintCacheEntry{expression: expression, constraint: constraint})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*constraintCacheEntry).expression)
+	}
+}
@@ -0,0 +1,78 @@
+package semver_test
+
+import (
+	"expvar"
+	"sync"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/semverexpvar"
+)
+
+func TestWithConstraintCache(t *testing.T) {
+	m := new(expvar.Map).Init()
+	s := semver.MustNew(semver.WithConstraintCache(2), semver.WithMetrics(semverexpvar.New(m)))
+	parse := func(expression string) *semver.Constraint {
+		t.Helper()
+		c, err := s.ParseConstraint(expression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	a := parse("^1.0.0")
+	b := parse(">=2.0.0 <3.0.0")
+	if parse("^1.0.0") != a {
+		t.Fatal("expected the cached constraint")
+	}
+	// The cache is full, so this evicts b, which was used least recently.
+	parse("~3.1.0")
+	if parse("^1.0.0") != a {
+		t.Fatal("expected the recently used constraint to stay cached")
+	}
+	if parse(">=2.0.0 <3.0.0") == b {
+		t.Fatal("expected the least recently used constraint to be evicted")
+	}
+	if _, err := s.ParseConstraint("^1.a"); err == nil {
+		t.Fatal("expected an error for an invalid constraint")
+	}
+	if _, err := s.ParseConstraint("^1.a"); err == nil {
+		t.Fatal("expected errors not to be cached")
+	}
+	expected := map[string]string{"constraint_hits": "2", "constraint_misses": "6"}
+	for key, value := range expected {
+		if v := m.Get(key); v == nil || v.String() != value {
+			t.Fatalf("expected `%s` to be %s, got %v", key, value, v)
+		}
+	}
+}
+
+func TestWithConstraintCacheConcurrent(t *testing.T) {
+	s := semver.MustNew(semver.WithConstraintCache(8))
+	expressions := []string{"^1.0.0", "~1.2.0", ">=2.0.0", "<3.0.0", "*", "1.2.3", "!=1.0.0", ">1.0.0 <2.0.0", "^0.1.0"}
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c, err := s.ParseConstraint(expressions[i%len(expressions)])
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := c.Check("1.5.0"); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestWithConstraintCacheSize(t *testing.T) {
+	if _, err := semver.New(semver.WithConstraintCache(0)); err == nil {
+		t.Fatal("expected an error for a cache without size")
+	}
+}
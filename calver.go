@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"regexp"
+	"strconv"
+)
+
+var reCalVer = regexp.MustCompile(`^(\d{4}|0?\d{1,3})\.(0?[1-9]|1[0-2])(?:\.(0|[1-9]\d*|0\d))?` +
+	`(?:-([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// parseCalVer parses a calendar version like `2024.06`, `2024.6.2` or `24.06.15-beta`, with an
+// optionally zero-padded month and micro or day. Short years count from 2000, like calver.org describes.
+func parseCalVer(version string) (*semVersion, bool) {
+	m := reCalVer.FindStringSubmatch(version)
+	if m == nil {
+		return nil, false
+	}
+	v := &semVersion{tag: m[4]}
+	var err error
+	if v.major, err = strconv.Atoi(m[1]); err != nil {
+		return nil, false
+	}
+	if len(m[1]) < 4 {
+		v.major += 2000
+	}
+	if v.minor, err = strconv.Atoi(m[2]); err != nil {
+		return nil, false
+	}
+	if m[3] != "" {
+		if v.revision, err = strconv.Atoi(m[3]); err != nil {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func validCalVer(version string) bool {
+	_, ok := parseCalVer(version)
+	return ok
+}
+
+// compareCalVer compares two valid calendar versions by year, month, micro and modifier, in which
+// a version with a modifier is lower than one without.
+func compareCalVer(a string, b string) int {
+	vA, _ := parseCalVer(a)
+	vB, _ := parseCalVer(b)
+	return compareSemVersions(vA, vB)
+}
+
+// DetectScheme detects if the version is a semver or calendar version. Versions that are valid by
+// both are considered semver. Only calendar versions with a full year are detected, since `24.06.1`
+// would be year 2024 while `24.6.1` is major 24. It returns false when the version is neither.
+func DetectScheme(version string) (Scheme, bool) {
+	if defaultSemver.Valid(version) {
+		return SchemeSemver, true
+	}
+	if _, ok := parseFullYearCalVer(version); ok {
+		return SchemeCalVer, true
+	}
+	return 0, false
+}
+
+// parseFullYearCalVer parses a calendar version with a four digit year, like `2024.06`.
+func parseFullYearCalVer(version string) (*semVersion, bool) {
+	if len(version) < 5 || version[4] != '.' {
+		return nil, false
+	}
+	return parseCalVer(version)
+}
+
+func validAuto(version string) bool {
+	_, ok := DetectScheme(version)
+	return ok
+}
+
+// compareAuto compares two semver or calendar versions, detecting the scheme of each. Both are
+// compared by their major or year, minor or month, patch or micro and prerelease or modifier, so
+// a project switching from semver to calver keeps increasing.
+func compareAuto(a string, b string) int {
+	return compareSemVersions(buildAuto(a), buildAuto(b))
+}
+
+func buildAuto(version string) *semVersion {
+	if v, ok := parseFullYearCalVer(version); ok && !defaultSemver.Valid(version) {
+		return v
+	}
+	v, _ := defaultSemver.buildVersion(version)
+	return v
+}
@@ -0,0 +1,74 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestSchemeCalVer(t *testing.T) {
+	versioning, err := semver.NewWithScheme(semver.SchemeCalVer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOrdered(t, versioning, [][]string{
+		{"2023.12"},
+		{"2024.1", "2024.01", "2024.01.0", "24.01"},
+		{"2024.06.2-beta"},
+		{"2024.06.2", "2024.6.02"},
+		{"2024.06.15"},
+		{"2024.10.1"},
+		{"2025.1"},
+	})
+	for _, version := range []string{"2024", "2024.13.1", "2024.0.1", "2024.06.", "20245.1.1", "2024.06.1-"} {
+		if versioning.Valid(version) {
+			t.Fatalf("expecting `%s` to be invalid", version)
+		}
+	}
+}
+
+func TestSchemeAuto(t *testing.T) {
+	versioning, err := semver.NewWithScheme(semver.SchemeAuto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOrdered(t, versioning, [][]string{
+		{"4.2.0"},
+		{"5.0.0-rc.1"},
+		{"5.0.0"},
+		{"2024.01", "2024.1.0"},
+		{"2024.06.1-rc.1"},
+		{"2024.06.1"},
+	})
+	for _, version := range []string{"latest", "24.06.1"} {
+		if versioning.Valid(version) {
+			t.Fatalf("expecting `%s` to be invalid", version)
+		}
+	}
+	if _, err := versioning.GreaterThanOrEqual("24.06.1", "25.1.0"); err == nil {
+		t.Fatal("expected an error for a calendar version with a short year")
+	}
+}
+
+func TestDetectScheme(t *testing.T) {
+	cases := []struct {
+		version string
+		scheme  semver.Scheme
+		ok      bool
+	}{
+		{"1.2.3", semver.SchemeSemver, true},
+		{"2024.6.1", semver.SchemeSemver, true},
+		{"2024.06.1", semver.SchemeCalVer, true},
+		{"2024.06", semver.SchemeCalVer, true},
+		{"24.6.1", semver.SchemeSemver, true},
+		{"24.06.1", 0, false},
+		{"latest", 0, false},
+	}
+	for k := range cases {
+		c := cases[k]
+		scheme, ok := semver.DetectScheme(c.version)
+		if scheme != c.scheme || ok != c.ok {
+			t.Fatalf("expected `%s` to be detected as %d (%t), got %d (%t)", c.version, c.scheme, c.ok, scheme, ok)
+		}
+	}
+}
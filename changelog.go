@@ -0,0 +1,107 @@
+package semver
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// reChangelogHeading matches Markdown headings that start with a version, like `## [1.2.3] - 2024-01-01`
+// or `## v1.2.3`. The version needs a major, minor and patch, so headings like `## [Unreleased]` and
+// `## 2024-01-01` don't match.
+var reChangelogHeading = regexp.MustCompile(
+	`^(#{1,6})[ \t]+\[?v?((?:\d+:)?\d+\.\d+\.\d+[^\]\s]*)\]?(?:[ \t]+-[ \t]+(.*?))?[ \t]*$`)
+
+// ChangelogSection is the section of a changelog for a single version.
+type ChangelogSection struct {
+	// Version is the version as written in the heading, without brackets or `v` prefix.
+	Version string
+	// Date is the text after the ` - ` separator of the heading, if any.
+	Date string
+	// Heading is the full heading line.
+	Heading string
+	// Line is the 1-based line number of the heading.
+	Line int
+	// Body is the text between the heading and the next heading of the same or a higher level,
+	// without surrounding blank lines.
+	Body string
+}
+
+type changelogHeading struct {
+	level   int
+	version string
+	date    string
+}
+
+// parseChangelogHeading parses the line when it's a heading starting with a version.
+func parseChangelogHeading(line string) (*changelogHeading, bool) {
+	matches := reChangelogHeading.FindStringSubmatch(line)
+	if matches == nil {
+		return nil, false
+	}
+	return &changelogHeading{level: len(matches[1]), version: matches[2], date: matches[3]}, true
+}
+
+// markdownHeadingLevel returns the level of the Markdown heading, or 0 if the line isn't one.
+func markdownHeadingLevel(line string) int {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || (level < len(line) && line[level] != ' ' && line[level] != '\t') {
+		return 0
+	}
+	return level
+}
+
+// ChangelogSection reads a Markdown changelog like CHANGELOG.md and returns the section for the version.
+// Headings are matched by precedence, so build metadata is ignored. The version headings up to the
+// section have to hold valid versions. When there's no section for the version a NotFound error is returned.
+func (s *Semver) ChangelogSection(r io.Reader, version string) (*ChangelogSection, error) {
+	if r == nil {
+		return nil, errors.New("reader cannot be nil")
+	}
+	v, err := s.Parse(version)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	scanner := bufio.NewScanner(r)
+	var section *ChangelogSection
+	var level int
+	var body []string
+	var line int
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if section != nil {
+			if l := markdownHeadingLevel(text); l > 0 && l <= level {
+				break
+			}
+			body = append(body, text)
+			continue
+		}
+		heading, ok := parseChangelogHeading(text)
+		if !ok {
+			continue
+		}
+		headingVersion, err := s.Parse(heading.version)
+		if err != nil {
+			return nil, errors.Annotatef(err, "changelog line %d", line)
+		}
+		if headingVersion.Compare(v) == 0 {
+			section = &ChangelogSection{Version: heading.version, Date: heading.date, Heading: text, Line: line}
+			level = heading.level
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if section == nil {
+		return nil, errors.NotFoundf("changelog section for version `%s`", version)
+	}
+	section.Body = strings.Trim(strings.Join(body, "\n"), "\n")
+	return section, nil
+}
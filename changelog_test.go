@@ -0,0 +1,95 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+const changelog = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+- Work in progress.
+
+## [1.2.3] - 2024-01-01
+
+### Fixed
+
+- Parsing of prereleases.
+
+## v1.2.0+build.7
+
+### Added
+
+- Constraints.
+- Selection.
+
+# Older releases
+
+## 1.0.0 - 2023-06-01
+
+- First release.
+`
+
+func TestChangelogSection(t *testing.T) {
+	cases := []struct {
+		version string
+		heading string
+		date    string
+		line    int
+		body    string
+	}{
+		{"1.2.3", "## [1.2.3] - 2024-01-01", "2024-01-01", 9, "### Fixed\n\n- Parsing of prereleases."},
+		{"1.2.0", "## v1.2.0+build.7", "", 15, "### Added\n\n- Constraints.\n- Selection."},
+		{"1.0.0+other", "## 1.0.0 - 2023-06-01", "2023-06-01", 24, "- First release."},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run("changelog-section-"+c.version, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			section, err := semver.ChangelogSection(strings.NewReader(changelog), c.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if section.Heading != c.heading || section.Date != c.date || section.Line != c.line {
+				t2.Fatalf("unexpected heading `%s` with date `%s` on line %d", section.Heading, section.Date, section.Line)
+			}
+			if section.Body != c.body {
+				t2.Fatalf("expected body `%s`, got `%s`", c.body, section.Body)
+			}
+		})
+	}
+}
+
+func TestChangelogSectionErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.ChangelogSection(strings.NewReader(changelog), "1.1.0"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+	if _, err := semver.ChangelogSection(strings.NewReader(changelog), invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := semver.ChangelogSection(nil, "1.0.0"); err == nil {
+		t.Fatal("expected an error for a nil reader")
+	}
+	invalid := "# Changelog\n\n## [1.3.01] - 2024-02-01\n\n## [1.2.3]\n"
+	if _, err := semver.ChangelogSection(strings.NewReader(invalid), "1.2.3"); err == nil ||
+		!strings.Contains(err.Error(), "line 3") {
+		t.Fatalf("expected an error for the invalid heading on line 3, got %v", err)
+	}
+	dated := "# Changelog\n\n## 2024-02-01\n\n## [1.2.3]\n"
+	if section, err := semver.ChangelogSection(strings.NewReader(dated), "1.2.3"); err != nil || section.Line != 5 {
+		t.Fatalf("expected the date heading to be skipped, got %v, %v", section, err)
+	}
+}
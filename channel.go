@@ -0,0 +1,93 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// channelOrder holds the rank of prerelease channel identifiers. Versions refer to it by pointer,
+// so they stay comparable.
+type channelOrder struct {
+	ranks map[string]int
+}
+
+// WithChannelOrder orders the prerelease channels from lowest to highest, like `dev`, `alpha`, `beta`,
+// `rc`, instead of by ASCII. Prerelease identifiers that are both channels are compared by their rank,
+// so `1.0.0-dev.3` is lower than `1.0.0-alpha.1`. Channels sort after numeric identifiers and before
+// all other alphanumeric identifiers, so `1.0.0-rc` is lower than `1.0.0-preview`; the identifiers that
+// aren't channels are compared as usual among themselves.
+//
+// Versions keep the order of the Semver they're parsed by, so it applies to Version.Compare and
+// constraints as well. CompareStrings and the core package always use the semver 2.0.0 order.
+func WithChannelOrder(channels ...string) Option {
+	return func(s *Semver) error {
+		order := &channelOrder{ranks: make(map[string]int, len(channels))}
+		for k, channel := range channels {
+			if !ValidPrereleaseIdentifier(channel) || isNumeric(channel) {
+				return errors.Errorf("channel `%s` is invalid", channel)
+			}
+			if _, ok := order.ranks[channel]; ok {
+				return errors.Errorf("channel `%s` is listed twice", channel)
+			}
+			order.ranks[channel] = k
+		}
+		s.channels = order
+		return nil
+	}
+}
+
+// comparePrerelease compares two dot-separated prerelease tags like the package-level comparePrerelease,
+// but compares the identifiers with compareIdentifier.
+func (o *channelOrder) comparePrerelease(a string, b string) int {
+	if a == b {
+		return 0
+	}
+	if a == "" || b == "" {
+		return comparePrerelease(a, b)
+	}
+	aIdentifiers := strings.Split(a, ".")
+	bIdentifiers := strings.Split(b, ".")
+	for k := 0; k < len(aIdentifiers) && k < len(bIdentifiers); k++ {
+		if c := o.compareIdentifier(aIdentifiers[k], bIdentifiers[k]); c != 0 {
+			return c
+		}
+	}
+	return compareInts(len(aIdentifiers), len(bIdentifiers))
+}
+
+// compareIdentifier compares a single prerelease identifier. Channels are compared by their rank and
+// have a fixed place between the numeric and the other alphanumeric identifiers, which keeps the order
+// transitive.
+func (o *channelOrder) compareIdentifier(a string, b string) int {
+	aRank, aChannel := o.ranks[a]
+	bRank, bChannel := o.ranks[b]
+	switch {
+	case aChannel && bChannel:
+		return compareInts(aRank, bRank)
+	case aChannel:
+		if isNumeric(b) {
+			return 1
+		}
+		return -1
+	case bChannel:
+		if isNumeric(a) {
+			return -1
+		}
+		return 1
+	}
+	return comparePrerelease(a, b)
+}
+
+// folded returns the order with lowercased channels, for a Semver that folds the case of prereleases.
+func (o *channelOrder) folded() (*channelOrder, error) {
+	folded := &channelOrder{ranks: make(map[string]int, len(o.ranks))}
+	for channel, rank := range o.ranks {
+		lower := strings.ToLower(channel)
+		if _, ok := folded.ranks[lower]; ok {
+			return nil, errors.Errorf("channel `%s` is listed twice when folding case", lower)
+		}
+		folded.ranks[lower] = rank
+	}
+	return folded, nil
+}
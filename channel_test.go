@@ -0,0 +1,117 @@
+package semver_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestWithChannelOrder(t *testing.T) {
+	s, err := semver.New(semver.WithChannelOrder("dev", "alpha", "beta", "rc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1.0.0-beta.2", "1.0.0-rc.1", -1},
+		{"1.0.0-dev.9", "1.0.0-alpha.1", -1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0-rc.1", "1.0.0-rc.1+build", 0},
+		{"1.0.0-rc", "1.0.0-rc.1", -1},
+		{"1.0.0-rc.2", "1.0.0-rc.10", -1},
+		{"1.0.0-dev", "1.0.0-preview", -1},
+		{"1.0.0-rc", "1.0.0-preview", -1},
+		{"1.0.0-rc.1", "1.0.0-a", -1},
+		{"1.0.0-2", "1.0.0-dev", -1},
+		{"1.0.0-alpha.beta", "1.0.0-alpha.rc", -1},
+		{"1.1.0-dev", "1.0.0-rc", 1},
+	}
+	for k := range cases {
+		c := cases[k]
+		a, err := s.Parse(c.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := s.Parse(c.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := a.Compare(b); result != c.expected {
+			t.Fatalf("expected comparing `%s` to `%s` to be %d, got %d", c.a, c.b, c.expected, result)
+		}
+		if result := b.Compare(a); result != -c.expected {
+			t.Fatalf("expected comparing `%s` to `%s` to be %d, got %d", c.b, c.a, -c.expected, result)
+		}
+	}
+
+	constraint, err := s.ParseConstraint(">=1.0.0-beta.1 <1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	selected, err := constraint.SelectPrerelease([]string{"1.0.0-dev.5", "1.0.0-beta.3", "1.0.0-rc.1", "1.0.0-alpha.7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if selected != "1.0.0-rc.1" {
+		t.Fatalf("expected `1.0.0-rc.1` to be selected, got `%s`", selected)
+	}
+
+	prereleases := []string{"1.0.0-1", "1.0.0-a", "1.0.0-alpha", "1.0.0-beta", "1.0.0-c", "1.0.0-dev", "1.0.0-rc", "1.0.0-z"}
+	for _, a := range prereleases {
+		for _, b := range prereleases {
+			for _, c := range prereleases {
+				vA, vB, vC := s.MustParse(a), s.MustParse(b), s.MustParse(c)
+				if vA.Compare(vB) < 0 && vB.Compare(vC) < 0 && vA.Compare(vC) >= 0 {
+					t.Fatalf("expected `%s` < `%s` < `%s` to be transitive", a, b, c)
+				}
+			}
+		}
+	}
+
+	versions := sortWith(t, s, "1.0.0-rc.1", "1.0.0-dev.1", "1.0.0", "1.0.0-beta.1", "1.0.0-alpha.1")
+	if sorted := strings.Join(versions, " "); sorted != "1.0.0-dev.1 1.0.0-alpha.1 1.0.0-beta.1 1.0.0-rc.1 1.0.0" {
+		t.Fatalf("unexpected order `%s`", sorted)
+	}
+}
+
+func sortWith(t *testing.T, s *semver.Semver, versions ...string) []string {
+	parsed := make([]*semver.Version, len(versions))
+	for k := range versions {
+		v, err := s.Parse(versions[k])
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed[k] = v
+	}
+	sort.Slice(parsed, func(i, j int) bool {
+		return parsed[i].Compare(parsed[j]) < 0
+	})
+	sorted := make([]string, len(parsed))
+	for k := range parsed {
+		sorted[k] = parsed[k].Original()
+	}
+	return sorted
+}
+
+func TestWithChannelOrderDefault(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sorted := strings.Join(sortWith(t, s, "1.0.0-dev.1", "1.0.0-alpha.1"), " "); sorted != "1.0.0-alpha.1 1.0.0-dev.1" {
+		t.Fatalf("expected the ASCII order without a channel order, got `%s`", sorted)
+	}
+}
+
+func TestWithChannelOrderErrors(t *testing.T) {
+	for _, channels := range [][]string{{"alpha", "alpha"}, {"alpha", ""}, {"1"}, {"rc.1"}, {"beta!"}} {
+		if _, err := semver.New(semver.WithChannelOrder(channels...)); err == nil {
+			t.Fatalf("expected an error for channels %v", channels)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package semver
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Metadata holds the registry information that can be attached to a version in a Collection.
+type Metadata struct {
+	ReleaseDate time.Time
+	Channel     string
+	Yanked      bool
+	// Digests maps an artifact name to its digest, like `linux-amd64` => `sha256:...`.
+	Digests map[string]string
+	// Attributes holds any additional information that has no dedicated field.
+	Attributes map[string]interface{}
+}
+
+// Entry is a version in a Collection together with its metadata.
+type Entry struct {
+	Version  *Version
+	Metadata Metadata
+}
+
+// Collection holds versions with their metadata, modelling the entries of a registry.
+type Collection struct {
+	semver  *Semver
+	entries []*Entry
+}
+
+// NewCollection returns a new, empty instance of Collection.
+func NewCollection(semver *Semver) *Collection {
+	return &Collection{semver: semver}
+}
+
+// Add parses the version and adds it to the collection with the given metadata.
+func (c *Collection) Add(version string, metadata Metadata) error {
+	v, err := c.semver.Parse(version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c.entries = append(c.entries, &Entry{Version: v, Metadata: metadata})
+	return nil
+}
+
+// Len returns the number of entries in the collection.
+func (c *Collection) Len() int {
+	return len(c.entries)
+}
+
+// Entries returns all entries in the order they were added.
+func (c *Collection) Entries() []*Entry {
+	return c.entries
+}
+
+// Versions returns all versions in the order they were added.
+func (c *Collection) Versions() []*Version {
+	versions := make([]*Version, len(c.entries))
+	for k := range c.entries {
+		versions[k] = c.entries[k].Version
+	}
+	return versions
+}
+
+// Filter returns a new collection with only the entries keep returns true for. A panic of keep fails
+// with a *HookPanicError as cause instead of crashing the caller.
+func (c *Collection) Filter(keep func(entry *Entry) bool) (filtered *Collection, err error) {
+	defer recoverHook("filter", &err)
+	return c.filter(keep), nil
+}
+
+func (c *Collection) filter(keep func(entry *Entry) bool) *Collection {
+	filtered := &Collection{semver: c.semver}
+	for k := range c.entries {
+		if keep(c.entries[k]) {
+			filtered.entries = append(filtered.entries, c.entries[k])
+		}
+	}
+	return filtered
+}
+
+// MarkYanked marks the entries with the same precedence as the version as yanked. It fails with a
+// NotFound error when the collection doesn't have the version.
+func (c *Collection) MarkYanked(version string) error {
+	v, err := c.semver.Parse(version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var found bool
+	for k := range c.entries {
+		if c.entries[k].Version.Compare(v) == 0 {
+			c.entries[k].Metadata.Yanked = true
+			found = true
+		}
+	}
+	if !found {
+		return errors.NotFoundf("version `%s` in the collection", version)
+	}
+	return nil
+}
+
+// Max returns the entry with the highest version that isn't yanked, including prereleases, if there is one.
+// Of equal versions the first one added is returned.
+func (c *Collection) Max() (*Entry, bool) {
+	var max *Entry
+	for k := range c.entries {
+		if !c.entries[k].Metadata.Yanked && (max == nil || c.entries[k].Version.Compare(max.Version) > 0) {
+			max = c.entries[k]
+		}
+	}
+	return max, max != nil
+}
+
+// Select returns the entry with the highest stable version that satisfies the constraint, like
+// Constraint.Select. Yanked entries are skipped, unless the constraint pins them with `=`. It fails
+// with a NotFound error when no entry satisfies the constraint.
+func (c *Collection) Select(constraint *Constraint) (*Entry, error) {
+	var selected *Entry
+	for k := range c.entries {
+		entry := c.entries[k]
+		if entry.Version.Prerelease != "" || (entry.Metadata.Yanked && !constraint.pins(entry.Version)) ||
+			!constraint.CheckVersion(entry.Version) {
+			continue
+		}
+		if selected == nil || entry.Version.Compare(selected.Version) > 0 {
+			selected = entry
+		}
+	}
+	if selected == nil {
+		return nil, errors.NotFoundf("entry satisfying `%s`", constraint)
+	}
+	return selected, nil
+}
+
+// ExcludeYanked returns a new collection without the yanked entries.
+func (c *Collection) ExcludeYanked() *Collection {
+	return c.filter(func(entry *Entry) bool {
+		return !entry.Metadata.Yanked
+	})
+}
+
+// OnChannel returns a new collection with only the entries released on the given channel.
+func (c *Collection) OnChannel(channel string) *Collection {
+	return c.filter(func(entry *Entry) bool {
+		return entry.Metadata.Channel == channel
+	})
+}
+
+// ReleasedBefore returns a new collection with only the entries released before t.
+// Entries without a release date are excluded.
+func (c *Collection) ReleasedBefore(t time.Time) *Collection {
+	return c.filter(func(entry *Entry) bool {
+		return !entry.Metadata.ReleaseDate.IsZero() && entry.Metadata.ReleaseDate.Before(t)
+	})
+}
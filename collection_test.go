@@ -0,0 +1,133 @@
+package semver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func newCollection(t *testing.T) *semver.Collection {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection := semver.NewCollection(s)
+	entries := []struct {
+		version  string
+		metadata semver.Metadata
+	}{
+		{"1.0.0", semver.Metadata{Channel: "stable", ReleaseDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{"1.1.0", semver.Metadata{Channel: "stable", Yanked: true}},
+		{"1.2.0-beta.1", semver.Metadata{Channel: "beta", ReleaseDate: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)}},
+		{"1.2.0", semver.Metadata{
+			Channel: "stable",
+			Digests: map[string]string{"linux-amd64": "sha256:abc"},
+		}},
+	}
+	for k := range entries {
+		if err := collection.Add(entries[k].version, entries[k].metadata); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return collection
+}
+
+func collectionOriginals(collection *semver.Collection) []string {
+	versions := collection.Versions()
+	originals := make([]string, len(versions))
+	for k := range versions {
+		originals[k] = versions[k].Original()
+	}
+	return originals
+}
+
+func TestCollectionFilters(t *testing.T) {
+	collection := newCollection(t)
+	if collection.Len() != 4 {
+		t.Fatalf("expected 4 entries, got %d", collection.Len())
+	}
+	cases := []struct {
+		name       string
+		collection *semver.Collection
+		expected   []string
+	}{
+		{"exclude-yanked", collection.ExcludeYanked(), []string{"1.0.0", "1.2.0-beta.1", "1.2.0"}},
+		{"on-channel", collection.OnChannel("stable"), []string{"1.0.0", "1.1.0", "1.2.0"}},
+		{"released-before", collection.ReleasedBefore(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)), []string{"1.0.0"}},
+		{"chained", collection.OnChannel("stable").ExcludeYanked(), []string{"1.0.0", "1.2.0"}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			originals := collectionOriginals(c.collection)
+			if len(originals) != len(c.expected) {
+				t2.Fatalf("expected %v, got %v", c.expected, originals)
+			}
+			for i := range originals {
+				if originals[i] != c.expected[i] {
+					t2.Fatalf("expected %v, got %v", c.expected, originals)
+				}
+			}
+		})
+	}
+	if digest := collection.Entries()[3].Metadata.Digests["linux-amd64"]; digest != "sha256:abc" {
+		t.Fatalf("expected the digest to be kept, got `%s`", digest)
+	}
+}
+
+func TestCollectionAddInvalid(t *testing.T) {
+	collection := newCollection(t)
+	if err := collection.Add(invalidVersions[0], semver.Metadata{}); err == nil {
+		t.Fatal("expected an error adding an invalid version")
+	}
+}
+
+func TestCollectionYanked(t *testing.T) {
+	collection := newCollection(t)
+	if err := collection.MarkYanked("1.2.0+build.7"); err != nil {
+		t.Fatal(err)
+	}
+	if err := collection.MarkYanked("1.3.0"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+	if max, ok := collection.Max(); !ok || max.Version.Original() != "1.2.0-beta.1" {
+		t.Fatalf("expected `1.2.0-beta.1` to be the max, got %v", max)
+	}
+	s := semver.MustNew()
+	cases := []struct {
+		constraint string
+		expected   string
+	}{
+		{"^1.0.0", "1.0.0"},
+		{"=1.1.0", "1.1.0"},
+		{"=1.2.0 || =1.1.0", "1.2.0"},
+		{">1.0.0", ""},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.constraint, func(t2 *testing.T) {
+			constraint, err := s.ParseConstraint(c.constraint)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			entry, err := collection.Select(constraint)
+			if c.expected == "" {
+				if !errors.IsNotFound(err) {
+					t2.Fatalf("expected a NotFound error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if entry.Version.Original() != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, entry.Version.Original())
+			}
+		})
+	}
+	if _, ok := semver.NewCollection(s).Max(); ok {
+		t.Fatal("expected no max in an empty collection")
+	}
+}
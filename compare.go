@@ -0,0 +1,163 @@
+package semver
+
+import (
+	"github.com/espal-digital-development/semver/core"
+	"github.com/juju/errors"
+)
+
+// Compare validates and compares version to compare by the semver 2.0.0 precedence rules, or the
+// default Options of WithOptions. It returns -1 when version is lower than compare, 1 when it's higher
+// and 0 when they're equal.
+func (s *Semver) Compare(version string, compare string) (int, error) {
+	return s.compare(version, compare)
+}
+
+// compare validates and compares version to compare by the default Options.
+func (s *Semver) compare(version string, compare string) (int, error) {
+	if s.schemeVersioning != nil {
+		c, err := s.schemeVersioning.compareValid(s.trimPrefix(version), s.trimPrefix(compare))
+		s.compared(err == nil)
+		return c, errors.Trace(err)
+	}
+	semVersion, semCompare, err := s.buildPair(version, compare)
+	if err != nil {
+		s.logFailure("compare", version+" "+compare, err)
+		s.compared(false)
+		return 0, errors.Trace(err)
+	}
+	s.compared(true)
+	return compareSemVersions(semVersion, semCompare), nil
+}
+
+// buildPair validates and builds both versions.
+func (s *Semver) buildPair(version string, compare string) (*semVersion, *semVersion, error) {
+	if s.tooLong(version) || s.tooLong(compare) {
+		return nil, nil, errors.Trace(s.lengthError(version, compare))
+	}
+	var err error
+	if version, err = s.input(version); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if compare, err = s.input(compare); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if !s.valid(version) {
+		if err := s.checkLeadingZeros(version); err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		return nil, nil, errors.Errorf("version `%s` is invalid", version)
+	}
+	if !s.valid(compare) {
+		if err := s.checkLeadingZeros(compare); err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		return nil, nil, errors.Errorf("compare `%s` is invalid", compare)
+	}
+	semVersion, err := s.buildVersion(version)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	semCompare, err := s.buildVersion(compare)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return semVersion, semCompare, nil
+}
+
+// compareSemVersions compares a and b according to the semver 2.0.0 precedence rules.
+// It returns -1 when a has a lower precedence, 1 when it's higher and 0 when they're equal.
+// Build metadata is ignored, unless either version has Options.StrictBuildMeta.
+func compareSemVersions(a *semVersion, b *semVersion) int {
+	if c := compareCores(a, b); c != 0 {
+		return c
+	}
+	channels := a.channels
+	if channels == nil {
+		channels = b.channels
+	}
+	var c int
+	if channels != nil {
+		c = channels.comparePrerelease(a.tag, b.tag)
+	} else {
+		c = comparePrerelease(a.tag, b.tag)
+	}
+	if c == 0 && (a.strictBuild || b.strictBuild) {
+		return compareBuild(a.build, b.build)
+	}
+	return c
+}
+
+// CompareCore validates and compares both versions by only their epoch, major, minor, patch and revision, so
+// prereleases of a version are equal to it. It returns -1 when a is lower than b, 1 when it's higher
+// and 0 when they're equal.
+func (s *Semver) CompareCore(a string, b string) (int, error) {
+	semA, semB, err := s.buildPair(a, b)
+	if err != nil {
+		s.logFailure("compare core", a+" "+b, err)
+		s.compared(false)
+		return 0, errors.Trace(err)
+	}
+	s.compared(true)
+	return compareCores(semA, semB), nil
+}
+
+// CompareCore compares the version to o by only their epoch, major, minor, patch and revision. The Max
+// sentinel is still the highest.
+func (v *Version) CompareCore(o *Version) int {
+	if v.sentinel != 0 || o.sentinel != 0 {
+		return compareInts(v.sentinel, o.sentinel)
+	}
+	return compareCores(v.semVersion(), o.semVersion())
+}
+
+func compareCores(a *semVersion, b *semVersion) int {
+	if c := compareInts(a.epoch, b.epoch); c != 0 {
+		return c
+	}
+	if c := compareInts(a.major, b.major); c != 0 {
+		return c
+	}
+	if c := compareInts(a.minor, b.minor); c != 0 {
+		return c
+	}
+	if c := compareInts(a.revision, b.revision); c != 0 {
+		return c
+	}
+	return compareInts(a.fourth, b.fourth)
+}
+
+func compareInts(a int, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares two dot-separated prerelease tags. A version without
+// a tag has a higher precedence than one with a tag.
+func comparePrerelease(a string, b string) int {
+	return core.ComparePrerelease(a, b)
+}
+
+// CompareStrings compares two valid versions by the semver 2.0.0 precedence rules without
+// allocating, which makes it suited for sorting large amounts of versions. It returns -1 when
+// a is lower than b, 1 when it's higher and 0 when they're equal. The outcome for invalid
+// versions is undefined, so validate untrusted input first. Epochs aren't supported.
+func CompareStrings(a string, b string) int {
+	return core.Compare(a, b)
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
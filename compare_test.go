@@ -0,0 +1,177 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var compareVersions = []struct {
+	a        string
+	b        string
+	expected int
+}{
+	{"1.2.3", "1.2.3", 0},
+	{"1.2.3", "1.2.4", -1},
+	{"1.10.0", "1.9.0", 1},
+	{"10.0.0", "9.99.99", 1},
+	{"1.0.0-rc.1", "1.0.0", -1},
+	{"1.0.0", "1.0.0-rc.1", 1},
+	{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+	{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+	{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+	{"1.0.0-rc.1", "1.0.0-rc.1", 0},
+	{"1.0.0+build.1", "1.0.0+build.2", 0},
+	{"1.0.0-rc.1+build", "1.0.0-rc.2", -1},
+	{"1.0.0-1", "1.0.0-a", -1},
+}
+
+func TestCompareStrings(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range compareVersions {
+		c := compareVersions[k]
+		if result := semver.CompareStrings(c.a, c.b); result != c.expected {
+			t.Fatalf("expected `%s` compared to `%s` to be %d, got %d", c.a, c.b, c.expected, result)
+		}
+		a, err := s.Parse(c.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := s.Parse(c.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := a.Compare(b); result != c.expected {
+			t.Fatalf("expected parsed `%s` compared to `%s` to be %d, got %d", c.a, c.b, c.expected, result)
+		}
+	}
+}
+
+func TestCompareStringsAllocations(t *testing.T) {
+	allocations := testing.AllocsPerRun(100, func() {
+		semver.CompareStrings("1.0.0-alpha.beta.1+build", "1.0.0-alpha.beta.2")
+	})
+	if allocations != 0 {
+		t.Fatalf("expected no allocations, got %f", allocations)
+	}
+}
+
+func BenchmarkCompareStrings(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		semver.CompareStrings("1.20.3-rc.1+build.5", "1.20.3-rc.2")
+	}
+}
+
+func BenchmarkVersionCompare(b *testing.B) {
+	s, err := semver.New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	v1, err := s.Parse("1.20.3-rc.1+build.5")
+	if err != nil {
+		b.Fatal(err)
+	}
+	v2, err := s.Parse("1.20.3-rc.2")
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v1.Compare(v2)
+	}
+}
+
+var compareCoreVersions = []struct {
+	a        string
+	b        string
+	expected int
+}{
+	{"1.2.3", "1.2.3", 0},
+	{"1.2.3-rc.1", "1.2.3", 0},
+	{"1.2.3-alpha", "1.2.3-beta", 0},
+	{"1.2.3+build.1", "1.2.3-rc.1+build.2", 0},
+	{"1.2.3-rc.1", "1.2.4-alpha", -1},
+	{"1.10.0-rc.1", "1.9.9", 1},
+	{"2.0.0", "1.99.99", 1},
+}
+
+func TestCompareCore(t *testing.T) {
+	for k := range compareCoreVersions {
+		c := compareCoreVersions[k]
+		t.Run("compare-core-"+c.a+"_"+c.b, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			result, err := semver.CompareCore(c.a, c.b)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if result != c.expected {
+				t2.Fatalf("expected %d, got %d", c.expected, result)
+			}
+			a, err := semver.Parse(c.a)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			b, err := semver.Parse(c.b)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if a.CompareCore(b) != c.expected || b.CompareCore(a) != -c.expected {
+				t2.Fatalf("expected Version.CompareCore to agree with %d", c.expected)
+			}
+		})
+	}
+}
+
+func TestCompareCoreErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CompareCore(invalidVersions[0], "1.0.0"); err == nil {
+		t.Fatal("expected an error for an invalid first version")
+	}
+	if _, err := s.CompareCore("1.0.0", invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid second version")
+	}
+	v, err := s.Parse("0.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero, max := semver.Zero(), semver.Max()
+	if zero.CompareCore(v) != 0 || max.CompareCore(v) != 1 {
+		t.Fatal("expected Zero to equal 0.0.0 and Max to stay the highest")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	instance, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s semver.VersioningV2 = instance
+	for k := range compareVersions {
+		c := compareVersions[k]
+		result, err := s.Compare(c.a, c.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result != c.expected {
+			t.Fatalf("expected `%s` compared to `%s` to be %d, got %d", c.a, c.b, c.expected, result)
+		}
+	}
+	if _, err := s.Compare("1.2", "1.2.0"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	var legacy semver.Versioning = s
+	if !legacy.Valid("1.2.3") {
+		t.Fatal("expected `1.2.3` to be valid through the Versioning interface")
+	}
+}
@@ -0,0 +1,45 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// CompatibleWith checks if the version is API-compatible with the base version. This means it
+// has the same major and is greater than or equal to the base. Because anything may change
+// before 1.0.0, a 0.y.z base requires the same minor and a 0.0.z base requires the exact patch.
+func (s *Semver) CompatibleWith(version string, base string) (bool, error) {
+	semVersion, semBase, err := s.buildPair(version, base)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if semVersion.major != semBase.major {
+		return false, nil
+	}
+	if semBase.major == 0 {
+		if semVersion.minor != semBase.minor {
+			return false, nil
+		}
+		if semBase.minor == 0 && semVersion.revision != semBase.revision {
+			return false, nil
+		}
+	}
+	return compareSemVersions(semVersion, semBase) >= 0, nil
+}
+
+// SameMajor checks if both versions have the same major.
+func (s *Semver) SameMajor(version string, compare string) (bool, error) {
+	semVersion, semCompare, err := s.buildPair(version, compare)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return semVersion.major == semCompare.major, nil
+}
+
+// SameMinor checks if both versions have the same major and minor.
+func (s *Semver) SameMinor(version string, compare string) (bool, error) {
+	semVersion, semCompare, err := s.buildPair(version, compare)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return semVersion.major == semCompare.major && semVersion.minor == semCompare.minor, nil
+}
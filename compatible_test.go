@@ -0,0 +1,83 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var (
+	compatibleVersions = []struct {
+		version    string
+		base       string
+		compatible bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"1.9.0", "1.2.3", true},
+		{"1.2.2", "1.2.3", false},
+		{"2.0.0", "1.2.3", false},
+		{"1.2.3-rc.1", "1.2.3", false},
+		{"0.2.5", "0.2.3", true},
+		{"0.3.0", "0.2.3", false},
+		{"0.0.3", "0.0.3", true},
+		{"0.0.4", "0.0.3", false},
+	}
+	sameVersions = []struct {
+		version   string
+		compare   string
+		sameMajor bool
+		sameMinor bool
+	}{
+		{"1.2.3", "1.2.9", true, true},
+		{"1.2.3", "1.5.0", true, false},
+		{"1.2.3", "2.2.3", false, false},
+		{"0.1.0-rc.1", "0.1.0", true, true},
+	}
+)
+
+func TestCompatibleWith(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range compatibleVersions {
+		c := compatibleVersions[k]
+		compatible, err := semver.CompatibleWith(c.version, c.base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if compatible != c.compatible {
+			t.Fatalf("expected `%s` compatible with `%s` to be %t", c.version, c.base, c.compatible)
+		}
+	}
+	if _, err := semver.CompatibleWith(invalidVersions[0], "1.0.0"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
+
+func TestSameMajorAndMinor(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range sameVersions {
+		c := sameVersions[k]
+		sameMajor, err := semver.SameMajor(c.version, c.compare)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sameMajor != c.sameMajor {
+			t.Fatalf("expected `%s` same major as `%s` to be %t", c.version, c.compare, c.sameMajor)
+		}
+		sameMinor, err := semver.SameMinor(c.version, c.compare)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sameMinor != c.sameMinor {
+			t.Fatalf("expected `%s` same minor as `%s` to be %t", c.version, c.compare, c.sameMinor)
+		}
+	}
+	if _, err := semver.SameMinor("1.0.0", invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid compare")
+	}
+}
@@ -0,0 +1,67 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ValidMajor checks if the value can be used as the major of a version.
+func ValidMajor(value string) bool {
+	return validNumericPart(value)
+}
+
+// ValidMinor checks if the value can be used as the minor of a version.
+func ValidMinor(value string) bool {
+	return validNumericPart(value)
+}
+
+// ValidPatch checks if the value can be used as the patch of a version.
+func ValidPatch(value string) bool {
+	return validNumericPart(value)
+}
+
+// validNumericPart checks if the value is a number without leading zeros that fits in an int.
+func validNumericPart(value string) bool {
+	if !validNumericIdentifier(value) {
+		return false
+	}
+	_, err := strconv.Atoi(value)
+	return err == nil
+}
+
+// ValidPrerelease checks if the value can be used as the prerelease of a version, without the leading `-`.
+func ValidPrerelease(value string) bool {
+	for _, identifier := range strings.Split(value, ".") {
+		if !ValidPrereleaseIdentifier(identifier) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidPrereleaseIdentifier checks if the value can be used as one of the dot-separated identifiers of
+// a prerelease. Numeric identifiers can't have leading zeros.
+func ValidPrereleaseIdentifier(value string) bool {
+	if !ValidBuildIdentifier(value) {
+		return false
+	}
+	return !isNumeric(value) || validNumericIdentifier(value)
+}
+
+// ValidBuildIdentifier checks if the value can be used as one of the dot-separated identifiers of
+// the build metadata.
+func ValidBuildIdentifier(value string) bool {
+	if value == "" {
+		return false
+	}
+	for k := 0; k < len(value); k++ {
+		if !isIdentifierChar(value[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+func validNumericIdentifier(value string) bool {
+	return isNumeric(value) && (value == "0" || value[0] != '0')
+}
@@ -0,0 +1,67 @@
+package semver_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestValidComponents(t *testing.T) {
+	maxInt := strconv.Itoa(int(^uint(0) >> 1))
+	numericValid := []string{"0", "1", "42", maxInt}
+	numericInvalid := []string{"", "01", "-1", "+1", "1.0", " 1", "a", "99999999999999999999"}
+	cases := []struct {
+		name     string
+		validate func(value string) bool
+		valid    []string
+		invalid  []string
+	}{
+		{"major", semver.ValidMajor, numericValid, numericInvalid},
+		{"minor", semver.ValidMinor, numericValid, numericInvalid},
+		{"patch", semver.ValidPatch, numericValid, numericInvalid},
+		{"prerelease-identifier", semver.ValidPrereleaseIdentifier,
+			[]string{"0", "1", "rc", "alpha-1", "0a", "-", "99999999999999999999"},
+			[]string{"", "01", "rc.1", "rc_1", "rc+1", "é"}},
+		{"prerelease", semver.ValidPrerelease,
+			[]string{"rc", "rc.1", "alpha.beta.0", "x-y.007z"},
+			[]string{"", "rc.", ".rc", "rc..1", "rc.01", "rc 1"}},
+		{"build-identifier", semver.ValidBuildIdentifier,
+			[]string{"0", "01", "sha", "5114f85", "-"},
+			[]string{"", "sha.1", "a_b", "a+b"}},
+		{"build-metadata", semver.ValidBuildMetadata,
+			[]string{"sha.5114f85", "001", "build.01"},
+			[]string{"", "sha.", "a..b", "a_b"}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run("valid-"+c.name, func(t2 *testing.T) {
+			for _, value := range c.valid {
+				if !c.validate(value) {
+					t2.Fatalf("expected `%s` to be valid", value)
+				}
+			}
+			for _, value := range c.invalid {
+				if c.validate(value) {
+					t2.Fatalf("expected `%s` to be invalid", value)
+				}
+			}
+		})
+	}
+}
+
+func TestValidComponentsAssemble(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	major, minor, patch, prerelease, build := "1", "20", "0", "rc.1", "sha.5114f85"
+	if !semver.ValidMajor(major) || !semver.ValidMinor(minor) || !semver.ValidPatch(patch) ||
+		!semver.ValidPrerelease(prerelease) || !semver.ValidBuildMetadata(build) {
+		t.Fatal("expected all components to be valid")
+	}
+	version := major + "." + minor + "." + patch + "-" + prerelease + "+" + build
+	if !s.Valid(version) {
+		t.Fatalf("expected the assembled `%s` to be valid", version)
+	}
+}
@@ -0,0 +1,342 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Operator is the operator of a Comparator.
+type Operator string
+
+// The operators of the constraint grammar, see Constraint.
+const (
+	OperatorEqual              Operator = "="
+	OperatorNotEqual           Operator = "!="
+	OperatorGreaterThan        Operator = ">"
+	OperatorGreaterThanOrEqual Operator = ">="
+	OperatorLessThan           Operator = "<"
+	OperatorLessThanOrEqual    Operator = "<="
+	OperatorTilde              Operator = "~"
+	OperatorCaret              Operator = "^"
+	OperatorAny                Operator = "*"
+)
+
+// operators is ordered so that longer operators are matched before their prefixes.
+var operators = []Operator{
+	OperatorNotEqual,
+	OperatorGreaterThanOrEqual,
+	OperatorLessThanOrEqual,
+	OperatorEqual,
+	OperatorGreaterThan,
+	OperatorLessThan,
+	OperatorTilde,
+	OperatorCaret,
+}
+
+// Constraint is a parsed constraint expression like `>=1.2.0 <2.0.0 || ^3.1.0`.
+//
+// An expression consists of groups separated by `||`, of which at least one has to match. A group
+// consists of comparators separated by whitespace or commas, which all have to match. A comparator
+// is an operator followed by a version:
+//
+//	=1.2.3 or 1.2.3   exactly 1.2.3
+//	!=1.2.3           anything but 1.2.3
+//	>1.2.3 >=1.2.3    greater than (or equal to) 1.2.3
+//	<1.2.3 <=1.2.3    less than (or equal to) 1.2.3
+//	~1.2.3            the same minor: >=1.2.3 <1.3.0-0
+//	^1.2.3            API-compatible: >=1.2.3 <2.0.0-0, >=0.2.3 <0.3.0-0 or >=0.0.3 <0.0.4-0
+//	*                 any version
+//
+// Versions can be partial like npm allows, where the missing parts or the parts that are `x`, `X` or `*`
+// match any value, so `1.2` and `1.2.x` are the 1.2 line: `>=1.2.0 <1.3.0-0`. With an operator they
+// expand by the npm rules, so `>1.2` is `>=1.3.0`, `<=1.2` is `<1.3.0-0` and `^1.2` is `^1.2.0`.
+//
+// Versions are compared by their precedence, so build metadata is ignored. Prereleases follow the npm
+// convention: a prerelease only satisfies a group when one of its comparators has a prerelease of the
+// same version, so `>=1.0.0-0 <2.0.0` matches `1.0.0-rc.1`, but `>=1.0.0`, `*` and `>=1.0.0-0 <2.0.0`
+// don't match `1.2.0-rc.1`. With WithIncludePrerelease they satisfy a range like any other version.
+type Constraint struct {
+	semver *Semver
+	groups [][]*Comparator
+}
+
+// Comparator is a single operator and version of a constraint, like `>=1.2.0`.
+type Comparator struct {
+	Operator Operator
+	// Version is nil for OperatorAny.
+	Version *Version
+}
+
+// ParseConstraint parses the constraint expression. With WithConstraintCache, parsed constraints are
+// cached and shared, so they must not be modified.
+func (s *Semver) ParseConstraint(expression string) (*Constraint, error) {
+	if s.schemeVersioning != nil {
+		return nil, errors.Trace(s.notSupported())
+	}
+	if s.constraints == nil {
+		c, err := s.parseConstraint(expression)
+		return c, errors.Trace(err)
+	}
+	c, ok := s.constraints.get(expression)
+	s.cacheLookup("constraint", ok)
+	if ok {
+		return c, nil
+	}
+	c, err := s.parseConstraint(expression)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	s.constraints.add(expression, c)
+	return c, nil
+}
+
+func (s *Semver) parseConstraint(expression string) (*Constraint, error) {
+	c := &Constraint{semver: s}
+	for _, group := range strings.Split(expression, "||") {
+		tokens := strings.FieldsFunc(group, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ','
+		})
+		if len(tokens) == 0 {
+			return nil, errors.Errorf("constraint `%s` has an empty group", expression)
+		}
+		var comparators []*Comparator
+		for k := 0; k < len(tokens); k++ {
+			token := tokens[k]
+			if isOperator(token) && k+1 < len(tokens) {
+				// Allow whitespace between the operator and the version, like `>= 1.2.3`.
+				k++
+				token += tokens[k]
+			}
+			parsed, err := s.parseComparator(token)
+			if err != nil {
+				return nil, errors.Annotatef(err, "constraint `%s`", expression)
+			}
+			comparators = append(comparators, parsed...)
+		}
+		c.groups = append(c.groups, comparators)
+	}
+	return c, nil
+}
+
+func isOperator(token string) bool {
+	for k := range operators {
+		if token == string(operators[k]) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseComparator parses the comparator of the token, which can be more than one for a partial version.
+func (s *Semver) parseComparator(token string) ([]*Comparator, error) {
+	if token == string(OperatorAny) {
+		return []*Comparator{{Operator: OperatorAny}}, nil
+	}
+	c := &Comparator{Operator: OperatorEqual}
+	for k := range operators {
+		if strings.HasPrefix(token, string(operators[k])) {
+			c.Operator = operators[k]
+			token = token[len(operators[k]):]
+			break
+		}
+	}
+	if v, set, ok, err := s.parsePartial(token); ok {
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		comparators, err := partialComparators(c.Operator, v, set)
+		return comparators, errors.Trace(err)
+	}
+	var err error
+	c.Version, err = s.parse(token)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return []*Comparator{c}, nil
+}
+
+// Check checks if the version satisfies the constraint.
+func (c *Constraint) Check(version string) (bool, error) {
+	v, err := c.semver.Parse(version)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return c.CheckVersion(v), nil
+}
+
+// CheckVersion checks if the parsed version satisfies the constraint.
+func (c *Constraint) CheckVersion(v *Version) bool {
+	return c.checkVersion(v, c.excludesPrerelease())
+}
+
+// excludesPrerelease checks if prereleases need a comparator of the same version to satisfy a group.
+func (c *Constraint) excludesPrerelease() bool {
+	return c.semver == nil || !c.semver.includePrerelease
+}
+
+func (c *Constraint) checkVersion(v *Version, excludePrerelease bool) bool {
+	for k := range c.groups {
+		if checkGroup(c.groups[k], v, excludePrerelease) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithIncludePrerelease lets prereleases satisfy constraints like any other version, like the
+// includePrerelease flag of npm, so `>=1.0.0` and `*` match `1.2.0-rc.1`. By default a prerelease
+// only satisfies a group with a prerelease of the same version.
+func WithIncludePrerelease() Option {
+	return func(s *Semver) error {
+		s.includePrerelease = true
+		return nil
+	}
+}
+
+func checkGroup(group []*Comparator, v *Version, excludePrerelease bool) bool {
+	if v.Prerelease != "" && excludePrerelease && !allowsPrerelease(group, v) {
+		return false
+	}
+	for k := range group {
+		if !group[k].check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsPrerelease checks if any comparator of the group has a prerelease of the same version as v.
+func allowsPrerelease(group []*Comparator, v *Version) bool {
+	for k := range group {
+		if group[k].Version != nil && group[k].Version.Prerelease != "" && group[k].Version.CompareCore(v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Comparator) check(v *Version) bool {
+	if c.Operator == OperatorAny {
+		return true
+	}
+	result := v.Compare(c.Version)
+	switch c.Operator {
+	case OperatorEqual:
+		return result == 0
+	case OperatorNotEqual:
+		return result != 0
+	case OperatorGreaterThan:
+		return result > 0
+	case OperatorGreaterThanOrEqual:
+		return result >= 0
+	case OperatorLessThan:
+		return result < 0
+	case OperatorLessThanOrEqual:
+		return result <= 0
+	case OperatorTilde, OperatorCaret:
+		upper := c.upper()
+		return result >= 0 && (upper == nil || v.Compare(upper) < 0)
+	}
+	return false
+}
+
+// upper returns the exclusive upper bound of a tilde or caret comparator. It's the lowest
+// prerelease of the next version, so prereleases of that version are excluded as well.
+// It's nil when the next version doesn't fit in an int.
+func (c *Comparator) upper() *Version {
+	v := c.Version
+	switch {
+	case c.Operator == OperatorTilde || (c.Operator == OperatorCaret && v.Major == 0 && v.Minor != 0):
+		if v.Minor == maxInt {
+			return nil
+		}
+		return &Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor + 1, Prerelease: "0"}
+	case c.Operator == OperatorCaret && v.Major == 0:
+		if v.Patch == maxInt {
+			return nil
+		}
+		return &Version{Epoch: v.Epoch, Patch: v.Patch + 1, Prerelease: "0"}
+	default:
+		if v.Major == maxInt {
+			return nil
+		}
+		return &Version{Epoch: v.Epoch, Major: v.Major + 1, Prerelease: "0"}
+	}
+}
+
+// String returns the constraint as a normalized expression, which parses back into the same constraint.
+// Groups are separated by ` || `, comparators by a single space and exact matches have no operator.
+func (c *Constraint) String() string {
+	groups := make([]string, len(c.groups))
+	for k := range c.groups {
+		comparators := make([]string, len(c.groups[k]))
+		for i := range c.groups[k] {
+			comparators[i] = c.groups[k][i].String()
+		}
+		groups[k] = strings.Join(comparators, " ")
+	}
+	return strings.Join(groups, " || ")
+}
+
+func (c *Comparator) String() string {
+	switch c.Operator {
+	case OperatorAny:
+		return string(OperatorAny)
+	case OperatorEqual:
+		return c.Version.canonical()
+	}
+	return string(c.Operator) + c.Version.canonical()
+}
+
+// pins checks if a group of the constraint only matches the version itself, like `=1.2.3`, which
+// selects the version even when it's yanked.
+func (c *Constraint) pins(v *Version) bool {
+	for k := range c.groups {
+		pinned := true
+		for _, comparator := range c.groups[k] {
+			if comparator.Operator != OperatorEqual || comparator.Version.Compare(v) != 0 {
+				pinned = false
+				break
+			}
+		}
+		if pinned {
+			return true
+		}
+	}
+	return false
+}
+
+// Select returns the highest of the versions that satisfies the constraint. Prereleases are skipped;
+// use SelectPrerelease to consider them as well. To skip yanked versions, select from a VersionSet or
+// Collection instead.
+func (c *Constraint) Select(versions []string) (string, error) {
+	v, err := c.selectMax(versions, false)
+	return v, errors.Trace(err)
+}
+
+// SelectPrerelease is like Select, but also considers prereleases, like WithIncludePrerelease does.
+func (c *Constraint) SelectPrerelease(versions []string) (string, error) {
+	v, err := c.selectMax(versions, true)
+	return v, errors.Trace(err)
+}
+
+func (c *Constraint) selectMax(versions []string, allowPrerelease bool) (string, error) {
+	var selected *Version
+	for k := range versions {
+		v, err := c.semver.Parse(versions[k])
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		if (!allowPrerelease && v.Prerelease != "") || !c.checkVersion(v, !allowPrerelease && c.excludesPrerelease()) {
+			continue
+		}
+		if selected == nil || v.Compare(selected) > 0 {
+			selected = v
+		}
+	}
+	if selected == nil {
+		return "", errors.NotFoundf("version satisfying `%s`", c)
+	}
+	return selected.Original(), nil
+}
@@ -0,0 +1,250 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+var (
+	constraintChecks = []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"=1.2.3", "1.2.3+build", true},
+		{"1.2.3", "1.2.4", false},
+		{"!=1.2.3", "1.2.4", true},
+		{"!=1.2.3", "1.2.3", false},
+		{">1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{">=1.2.3", "1.2.3", true},
+		{"<1.2.3", "1.2.3-rc.1", false},
+		{"<1.2.3-0", "1.2.3-rc.1", false},
+		{">=1.2.3-alpha <1.2.3", "1.2.3-rc.1", true},
+		{"<=1.2.3", "1.2.3", true},
+		{"<=1.2.3", "1.2.4", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0-rc.1", false},
+		{"~1.2.3", "1.2.2", false},
+		{"^1.2.3", "1.9.0", true},
+		{"^1.2.3", "2.0.0-alpha", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+		{"*", "0.0.0-alpha", false},
+		{">=1.2.0 <2.0.0", "1.5.0", true},
+		{">=1.2.0 <2.0.0", "2.0.0", false},
+		{">= 1.2.0, < 2.0.0", "1.9.9", true},
+		{"<1.0.0 || >=2.0.0", "1.5.0", false},
+		{"<1.0.0 || >=2.0.0", "2.1.0", true},
+		{"<1.0.0 || >=2.0.0", "0.9.0", true},
+	}
+	constraintStrings = map[string]string{
+		"1.2.3":                  "1.2.3",
+		"=1.2.3":                 "1.2.3",
+		">=  1.2.3":              ">=1.2.3",
+		">=1.2.0,<2.0.0":         ">=1.2.0 <2.0.0",
+		" ^1.2.3 ||~2.0.0 ":      "^1.2.3 || ~2.0.0",
+		"*":                      "*",
+		"!=1.0.0-rc.1+build.5":   "!=1.0.0-rc.1+build.5",
+		"<1.0.0\t||\t>=2.0.0 <3": "<1.0.0 || >=2.0.0 <3.0.0-0",
+	}
+	invalidConstraints = []string{
+		"",
+		"||",
+		">=1.2.0 ||",
+		">>1.2.3",
+		"~>1.2.3",
+		"1.2.y",
+		">=",
+		"=>1.2.3",
+		"1.2.3 - 2.0.0",
+	}
+)
+
+func TestConstraintCheck(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range constraintChecks {
+		c := constraintChecks[k]
+		t.Run("check-"+c.constraint+"_"+c.version, func(t2 *testing.T) {
+			constraint, err := s.ParseConstraint(c.constraint)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			result, err := constraint.Check(c.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if result != c.expected {
+				t2.Fatalf("expected `%s` satisfying `%s` to be %t", c.version, c.constraint, c.expected)
+			}
+		})
+	}
+}
+
+func TestConstraintString(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for expression, expected := range constraintStrings {
+		constraint, err := s.ParseConstraint(expression)
+		if expected == "" {
+			if err == nil {
+				t.Fatalf("expected `%s` to be invalid", expression)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if constraint.String() != expected {
+			t.Fatalf("expected `%s` to normalize to `%s`, got `%s`", expression, expected, constraint.String())
+		}
+		reparsed, err := s.ParseConstraint(constraint.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reparsed.String() != constraint.String() {
+			t.Fatalf("expected `%s` to round-trip, got `%s`", constraint.String(), reparsed.String())
+		}
+	}
+}
+
+func TestConstraintErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range invalidConstraints {
+		if _, err := s.ParseConstraint(invalidConstraints[k]); err == nil {
+			t.Fatalf("expected `%s` to be an invalid constraint", invalidConstraints[k])
+		}
+	}
+	constraint, err := s.ParseConstraint("*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := constraint.Check(invalidVersions[0]); err == nil {
+		t.Fatal("expected an error checking an invalid version")
+	}
+}
+
+func TestInConstraint(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	constraint, err := s.ParseConstraint("^1.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	collection, err := newCollection(t).Where(semver.InConstraint(constraint))
+	if err != nil {
+		t.Fatal(err)
+	}
+	originals := collectionOriginals(collection)
+	if len(originals) != 2 || originals[0] != "1.1.0" || originals[1] != "1.2.0" {
+		t.Fatalf("unexpected versions in constraint %v", originals)
+	}
+}
+
+func TestConstraintSelect(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	versions := []string{"1.0.0", "1.4.2", "1.5.0-rc.1", "1.4.10", "2.0.0", "2.1.0-beta"}
+	cases := []struct {
+		constraint string
+		stable     string
+		prerelease string
+	}{
+		{"^1.0.0", "1.4.10", "1.5.0-rc.1"},
+		{"~1.4.0", "1.4.10", "1.4.10"},
+		{">=2.0.0", "2.0.0", "2.1.0-beta"},
+		{"<1.0.0 || 1.4.2", "1.4.2", "1.4.2"},
+		{">=2.1.0-alpha <3.0.0", "", "2.1.0-beta"},
+	}
+	for k := range cases {
+		c := cases[k]
+		constraint, err := s.ParseConstraint(c.constraint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stable, err := constraint.Select(versions)
+		if c.stable == "" {
+			if !errors.IsNotFound(err) {
+				t.Fatalf("expected no stable version for `%s`, got `%s` (%v)", c.constraint, stable, err)
+			}
+		} else if err != nil || stable != c.stable {
+			t.Fatalf("expected `%s` for `%s`, got `%s` (%v)", c.stable, c.constraint, stable, err)
+		}
+		prerelease, err := constraint.SelectPrerelease(versions)
+		if err != nil || prerelease != c.prerelease {
+			t.Fatalf("expected `%s` for `%s` with prereleases, got `%s` (%v)", c.prerelease, c.constraint, prerelease, err)
+		}
+	}
+	constraint, err := s.ParseConstraint("*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := constraint.Select([]string{"1.0.0", invalidVersions[0]}); err == nil || errors.IsNotFound(err) {
+		t.Fatalf("expected an error for an invalid version, got %v", err)
+	}
+}
+
+func TestWithIncludePrerelease(t *testing.T) {
+	include, err := semver.New(semver.WithIncludePrerelease())
+	if err != nil {
+		t.Fatal(err)
+	}
+	exclude, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		constraint string
+		version    string
+		included   bool
+		excluded   bool
+	}{
+		{">=1.0.0", "1.2.0-rc.1", true, false},
+		{">=1.0.0-0 <2.0.0", "1.0.0-rc.1", true, true},
+		{">=1.0.0-0 <2.0.0", "1.2.0-rc.1", true, false},
+		{">=1.0.0-0 <2.0.0", "1.2.0", true, true},
+		{"^1.2.3-beta.2", "1.2.3-beta.4", true, true},
+		{"^1.2.3-beta.2", "1.2.4-beta.4", true, false},
+		{"<2.0.0 || >=2.1.0-alpha", "2.1.0-beta", true, true},
+		{"*", "1.0.0-rc.1", true, false},
+		{"1.0.0-rc.1", "1.0.0-rc.1", true, true},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.constraint+" "+c.version, func(t2 *testing.T) {
+			for _, mode := range []struct {
+				semver   *semver.Semver
+				expected bool
+			}{{include, c.included}, {exclude, c.excluded}} {
+				constraint, err := mode.semver.ParseConstraint(c.constraint)
+				if err != nil {
+					t2.Fatal(err)
+				}
+				result, err := constraint.Check(c.version)
+				if err != nil {
+					t2.Fatal(err)
+				}
+				if result != mode.expected {
+					t2.Fatalf("expected %v, got %v", mode.expected, result)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+)
+
+const maxInt = int(^uint(0) >> 1)
+
+// operators is ordered so that longer operators are matched before their prefixes.
+var operators = []string{"!=", ">=", "<=", "=", ">", "<", "~", "^"}
+
+// Constraint is a parsed constraint expression like `>=1.2.0 <2.0.0 || ^3.1.0`, with the grammar and the
+// default semantics of the Constraint of the semver package.
+//
+// An expression consists of groups separated by `||`, of which at least one has to match. A group
+// consists of comparators separated by whitespace or commas, which all have to match. A comparator
+// is one of the operators `=`, `!=`, `>`, `>=`, `<`, `<=`, `~` and `^` followed by a version, or `*`.
+// Versions can be partial like `1.2` or `1.2.x`, which expand by the npm rules.
+//
+// A prerelease only satisfies a group when one of its comparators has a prerelease of the same major,
+// minor and patch, so `>=1.0.0-0 <2.0.0` matches `1.0.0-rc.1`, but not `1.2.0-rc.1`.
+type Constraint struct {
+	groups [][]comparator
+}
+
+type comparator struct {
+	operator string
+	version  Version
+	// upper is the exclusive upper bound of a tilde or caret comparator. It's nil when it's unbounded.
+	upper *Version
+}
+
+// ParseConstraint parses the constraint expression. It fails with ErrInvalidConstraint, ErrInvalid or ErrOverflow.
+func ParseConstraint(expression string) (*Constraint, error) {
+	c := &Constraint{}
+	for _, group := range strings.Split(expression, "||") {
+		tokens := strings.FieldsFunc(group, func(r rune) bool {
+			return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == ','
+		})
+		if len(tokens) == 0 {
+			return nil, ErrInvalidConstraint
+		}
+		var comparators []comparator
+		for k := 0; k < len(tokens); k++ {
+			token := tokens[k]
+			if isOperator(token) && k+1 < len(tokens) {
+				// Allow whitespace between the operator and the version, like `>= 1.2.3`.
+				k++
+				token += tokens[k]
+			}
+			parsed, err := parseComparator(token)
+			if err != nil {
+				return nil, err
+			}
+			comparators = append(comparators, parsed...)
+		}
+		c.groups = append(c.groups, comparators)
+	}
+	return c, nil
+}
+
+// Check checks if the version satisfies the constraint.
+func (c *Constraint) Check(v Version) bool {
+	for k := range c.groups {
+		if checkGroup(c.groups[k], v) {
+			return true
+		}
+	}
+	return false
+}
+
+func isOperator(token string) bool {
+	for k := range operators {
+		if token == operators[k] {
+			return true
+		}
+	}
+	return false
+}
+
+// parseComparator parses the comparator of the token, which can be more than one for a partial version.
+func parseComparator(token string) ([]comparator, error) {
+	if token == "*" {
+		return []comparator{{operator: "*"}}, nil
+	}
+	operator := "="
+	for k := range operators {
+		if strings.HasPrefix(token, operators[k]) {
+			operator = operators[k]
+			token = token[len(operators[k]):]
+			break
+		}
+	}
+	if v, set, ok, err := parsePartial(token); ok {
+		if err != nil {
+			return nil, err
+		}
+		return partialComparators(operator, v, set)
+	}
+	v, err := Parse(token)
+	if err != nil {
+		return nil, err
+	}
+	return []comparator{newComparator(operator, v)}, nil
+}
+
+func newComparator(operator string, v Version) comparator {
+	c := comparator{operator: operator, version: v}
+	if operator == "~" || operator == "^" {
+		c.upper = upperBound(operator, v)
+	}
+	return c
+}
+
+// upperBound returns the exclusive upper bound of a tilde or caret comparator. It's the lowest
+// prerelease of the next version, so prereleases of that version are excluded as well.
+// It's nil when the next version doesn't fit in an int.
+func upperBound(operator string, v Version) *Version {
+	switch {
+	case operator == "~" || (v.Major == 0 && v.Minor != 0):
+		if v.Minor == maxInt {
+			return nil
+		}
+		return &Version{Major: v.Major, Minor: v.Minor + 1, Prerelease: "0"}
+	case v.Major == 0:
+		if v.Patch == maxInt {
+			return nil
+		}
+		return &Version{Patch: v.Patch + 1, Prerelease: "0"}
+	default:
+		if v.Major == maxInt {
+			return nil
+		}
+		return &Version{Major: v.Major + 1, Prerelease: "0"}
+	}
+}
+
+// parsePartial parses a version with missing parts or parts that are `x`, `X` or `*`, like `1.2` or `1.x`.
+// It returns how many parts are set, and false when the version isn't partial.
+func parsePartial(version string) (Version, int, bool, error) {
+	if version == "" || strings.ContainsAny(version, "-+") {
+		return Version{}, 0, false, nil
+	}
+	fields := strings.Split(version, ".")
+	if len(fields) > 3 {
+		return Version{}, 0, false, nil
+	}
+	set := len(fields)
+	for k := range fields {
+		if fields[k] == "x" || fields[k] == "X" || fields[k] == "*" {
+			if set == len(fields) {
+				set = k
+			}
+		} else if set < len(fields) || !isNumeric(fields[k]) || (len(fields[k]) > 1 && fields[k][0] == '0') {
+			return Version{}, 0, false, nil
+		}
+	}
+	if set == 3 {
+		return Version{}, 0, false, nil
+	}
+	var v Version
+	parts := []*int{&v.Major, &v.Minor, &v.Patch}
+	for k := 0; k < set; k++ {
+		n, err := strconv.Atoi(fields[k])
+		if err != nil {
+			return Version{}, 0, true, ErrOverflow
+		}
+		*parts[k] = n
+	}
+	return v, set, true, nil
+}
+
+// partialComparators expands a comparator with a partial version into comparators with full versions
+// by the npm rules, where the parts that aren't set match any value. So `1.2` is `>=1.2.0 <1.3.0-0`,
+// `<=1.2` is `<1.3.0-0`, `>1.2` is `>=1.3.0` and `^1.2` is `^1.2.0`.
+func partialComparators(operator string, v Version, set int) ([]comparator, error) {
+	if set == 0 {
+		switch operator {
+		case "=", ">=", "<=", "~", "^":
+			return []comparator{{operator: "*"}}, nil
+		}
+		return nil, ErrInvalidConstraint
+	}
+	next := Version{Major: v.Major + 1}
+	if set == 2 {
+		next = Version{Major: v.Major, Minor: v.Minor + 1}
+	}
+	if (set == 1 && v.Major == maxInt) || (set == 2 && v.Minor == maxInt) {
+		return nil, ErrOverflow
+	}
+	upper := next
+	upper.Prerelease = "0"
+	line := []comparator{{operator: ">=", version: v}, {operator: "<", version: upper}}
+	switch operator {
+	case "=":
+		return line, nil
+	case ">=":
+		return line[:1], nil
+	case ">":
+		return []comparator{{operator: ">=", version: next}}, nil
+	case "<":
+		return []comparator{{operator: "<", version: Version{Major: v.Major, Minor: v.Minor, Prerelease: "0"}}}, nil
+	case "<=":
+		return line[1:], nil
+	case "^":
+		if v.Major == 0 && (set == 1 || v.Minor == 0) {
+			return line, nil
+		}
+	case "~":
+		if set == 1 {
+			return line, nil
+		}
+	case "!=":
+		return nil, ErrInvalidConstraint
+	}
+	return []comparator{newComparator(operator, v)}, nil
+}
+
+func checkGroup(group []comparator, v Version) bool {
+	if v.Prerelease != "" && !allowsPrerelease(group, v) {
+		return false
+	}
+	for k := range group {
+		if !group[k].check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsPrerelease checks if any comparator of the group has a prerelease of the same version as v.
+func allowsPrerelease(group []comparator, v Version) bool {
+	for k := range group {
+		w := group[k].version
+		if group[k].operator != "*" && w.Prerelease != "" && w.Major == v.Major && w.Minor == v.Minor && w.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+func (c comparator) check(v Version) bool {
+	if c.operator == "*" {
+		return true
+	}
+	result := CompareVersions(v, c.version)
+	switch c.operator {
+	case "=":
+		return result == 0
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case "~", "^":
+		return result >= 0 && (c.upper == nil || CompareVersions(v, *c.upper) < 0)
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+// Package core implements semver 2.0.0 validation, parsing, comparison and constraints without regular
+// expressions or any other heavy dependency, so it compiles cleanly to WASM and with TinyGo.
+package core
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalid is returned for versions that don't follow the semver 2.0.0 grammar.
+	ErrInvalid = errors.New("invalid version")
+	// ErrOverflow is returned for versions with a major, minor or patch too big to fit in an int.
+	ErrOverflow = errors.New("version part overflows int")
+	// ErrInvalidConstraint is returned for constraints that don't follow the constraint grammar.
+	ErrInvalidConstraint = errors.New("invalid constraint")
+)
+
+// Version is a parsed semver version.
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease string
+	Build      string
+}
+
+// Valid checks if the version follows the semver 2.0.0 grammar and its major, minor and patch fit in an int.
+func Valid(version string) bool {
+	_, err := Parse(version)
+	return err == nil
+}
+
+// Parse parses the version. It fails with ErrInvalid, or with ErrOverflow when the version follows
+// the grammar but a part doesn't fit in an int.
+func Parse(version string) (Version, error) {
+	var v Version
+	var overflow bool
+	rest := version
+	parts := []*int{&v.Major, &v.Minor, &v.Patch}
+	for k := range parts {
+		length := numberLength(rest)
+		if length == 0 || (length > 1 && rest[0] == '0') {
+			return Version{}, ErrInvalid
+		}
+		n, err := strconv.Atoi(rest[:length])
+		if err != nil {
+			overflow = true
+		}
+		*parts[k] = n
+		rest = rest[length:]
+		if k < len(parts)-1 {
+			if !strings.HasPrefix(rest, ".") {
+				return Version{}, ErrInvalid
+			}
+			rest = rest[1:]
+		}
+	}
+	if strings.HasPrefix(rest, "-") {
+		end := strings.IndexByte(rest, '+')
+		if end < 0 {
+			end = len(rest)
+		}
+		v.Prerelease = rest[1:end]
+		if !validIdentifiers(v.Prerelease, true) {
+			return Version{}, ErrInvalid
+		}
+		rest = rest[end:]
+	}
+	if strings.HasPrefix(rest, "+") {
+		v.Build = rest[1:]
+		if !validIdentifiers(v.Build, false) {
+			return Version{}, ErrInvalid
+		}
+		rest = ""
+	}
+	if rest != "" {
+		return Version{}, ErrInvalid
+	}
+	if overflow {
+		return Version{}, ErrOverflow
+	}
+	return v, nil
+}
+
+// validIdentifiers checks a dot-separated list of identifiers. Numeric prerelease identifiers
+// can't have leading zeros.
+func validIdentifiers(identifiers string, prerelease bool) bool {
+	for {
+		identifier, rest := nextIdentifier(identifiers)
+		if identifier == "" {
+			return false
+		}
+		for i := 0; i < len(identifier); i++ {
+			c := identifier[i]
+			if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && c != '-' {
+				return false
+			}
+		}
+		if prerelease && len(identifier) > 1 && identifier[0] == '0' && isNumeric(identifier) {
+			return false
+		}
+		if rest == "" {
+			return !strings.HasSuffix(identifiers, ".")
+		}
+		identifiers = rest
+	}
+}
+
+// Compare compares two valid versions by the semver 2.0.0 precedence rules without allocating.
+// It returns -1 when a is lower than b, 1 when it's higher and 0 when they're equal. The outcome
+// for invalid versions is undefined, so validate untrusted input first.
+func Compare(a string, b string) int {
+	for part := 0; part < 3; part++ {
+		aLength := numberLength(a)
+		bLength := numberLength(b)
+		if c := compareInts(aLength, bLength); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a[:aLength], b[:bLength]); c != 0 {
+			return c
+		}
+		a, b = a[aLength:], b[bLength:]
+		if part < 2 {
+			a, b = strings.TrimPrefix(a, "."), strings.TrimPrefix(b, ".")
+		}
+	}
+	return ComparePrerelease(prereleaseOf(a), prereleaseOf(b))
+}
+
+// CompareVersions compares two parsed versions by the semver 2.0.0 precedence rules.
+func CompareVersions(a Version, b Version) int {
+	if c := compareInts(a.Major, b.Major); c != 0 {
+		return c
+	}
+	if c := compareInts(a.Minor, b.Minor); c != 0 {
+		return c
+	}
+	if c := compareInts(a.Patch, b.Patch); c != 0 {
+		return c
+	}
+	return ComparePrerelease(a.Prerelease, b.Prerelease)
+}
+
+// ComparePrerelease compares two dot-separated prerelease tags. A version without
+// a tag has a higher precedence than one with a tag.
+func ComparePrerelease(a string, b string) int {
+	if a == b {
+		return 0
+	}
+	if a == "" {
+		return 1
+	}
+	if b == "" {
+		return -1
+	}
+	for {
+		aIdentifier, aRest := nextIdentifier(a)
+		bIdentifier, bRest := nextIdentifier(b)
+		if c := compareIdentifier(aIdentifier, bIdentifier); c != 0 {
+			return c
+		}
+		if aRest == "" || bRest == "" {
+			return compareInts(len(aRest), len(bRest))
+		}
+		a, b = aRest, bRest
+	}
+}
+
+// compareIdentifier compares a single prerelease identifier. Numeric identifiers are compared
+// numerically (without converting them, so they can't overflow) and always have a lower
+// precedence than alphanumeric ones.
+func compareIdentifier(a string, b string) int {
+	aNumeric := isNumeric(a)
+	bNumeric := isNumeric(b)
+	switch {
+	case aNumeric && bNumeric:
+		if c := compareInts(len(a), len(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// nextIdentifier splits off the first identifier of a dot-separated tag without allocating.
+// The rest is empty once the last identifier is reached.
+func nextIdentifier(tag string) (identifier string, rest string) {
+	if i := strings.IndexByte(tag, '.'); i >= 0 {
+		return tag[:i], tag[i+1:]
+	}
+	return tag, ""
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func numberLength(s string) int {
+	var i int
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+// prereleaseOf returns the prerelease tag of what follows a version's core.
+func prereleaseOf(rest string) string {
+	if !strings.HasPrefix(rest, "-") {
+		return ""
+	}
+	rest = rest[1:]
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		return rest[:i]
+	}
+	return rest
+}
+
+func compareInts(a int, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
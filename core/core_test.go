@@ -0,0 +1,190 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver/core"
+)
+
+var (
+	validVersions = []string{
+		"0.0.0",
+		"1.2.3",
+		"10.20.30",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-0.3.7",
+		"1.0.0-x.7.z.92",
+		"1.0.0-x-y-z.--",
+		"1.0.0-alpha+001",
+		"1.0.0+20130313144700",
+		"1.0.0-beta+exp.sha.5114f85",
+		"1.0.0+21AF26D3----117B344092BD",
+		"2.0.0+build.0001",
+	}
+	invalidVersions = []string{
+		"",
+		"1",
+		"1.2",
+		"1.2.3.4",
+		"01.2.3",
+		"1.02.3",
+		"1.2.03",
+		"1.2.3-",
+		"1.2.3+",
+		"1.2.3-01",
+		"1.2.3-alpha..1",
+		"1.2.3-alpha.",
+		"1.2.3-alpha_beta",
+		"1.2.3+build..1",
+		"1.2.3 ",
+		"v1.2.3",
+		"-1.2.3",
+		"99999999999999999999.0.0",
+	}
+)
+
+func TestValid(t *testing.T) {
+	for k := range validVersions {
+		if !core.Valid(validVersions[k]) {
+			t.Fatalf("expecting `%s` to be valid", validVersions[k])
+		}
+	}
+	for k := range invalidVersions {
+		if core.Valid(invalidVersions[k]) {
+			t.Fatalf("expecting `%s` to be invalid", invalidVersions[k])
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	v, err := core.Parse("1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != (core.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}) {
+		t.Fatalf("unexpected parsed version %+v", v)
+	}
+	if _, err := core.Parse("1.2"); err != core.ErrInvalid {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+	if _, err := core.Parse("1.99999999999999999999.0"); err != core.ErrOverflow {
+		t.Fatalf("expected ErrOverflow, got %v", err)
+	}
+	if _, err := core.Parse("99999999999999999999.0.0_"); err != core.ErrInvalid {
+		t.Fatalf("expected ErrInvalid, got %v", err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	ordered := []string{
+		"1.0.0-0",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.10.0",
+		"10.0.0",
+	}
+	for k := 1; k < len(ordered); k++ {
+		a, b := ordered[k-1], ordered[k]
+		if core.Compare(a, b) != -1 || core.Compare(b, a) != 1 || core.Compare(a, a) != 0 {
+			t.Fatalf("expected `%s` to be lower than `%s`", a, b)
+		}
+		vA, err := core.Parse(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vB, err := core.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if core.CompareVersions(vA, vB) != -1 {
+			t.Fatalf("expected parsed `%s` to be lower than `%s`", a, b)
+		}
+	}
+	if core.Compare("1.0.0+a", "1.0.0+b") != 0 {
+		t.Fatal("expected build metadata to be ignored")
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	tests := []struct {
+		expression string
+		version    string
+		expected   bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"=1.2.3", "1.2.4", false},
+		{"!=1.2.3", "1.2.4", true},
+		{">1.2.3", "1.2.3", false},
+		{">= 1.2.3", "1.2.3+build.1", true},
+		{"<1.2.3", "1.2.2", true},
+		{"<=1.2.3", "1.2.4", false},
+		{">=1.2.0, <2.0.0", "1.9.9", true},
+		{">=1.2.0 <2.0.0", "2.0.0", false},
+		{"<1.0.0 || >=2.0.0", "2.1.0", true},
+		{"<1.0.0 || >=2.0.0", "1.1.0", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"^1.2.3", "1.9.0", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.4", false},
+		{"*", "3.4.5", true},
+		{"1.2", "1.2.7", true},
+		{"1.2.x", "1.3.0", false},
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0", true},
+		{"<=1.2", "1.2.9", true},
+		{"<1.2", "1.1.9", true},
+		{"^1.2", "1.9.0", true},
+		{"~1", "1.9.0", true},
+		{"^0.x", "1.0.0", false},
+		{"x", "0.0.0", true},
+		{">=1.0.0", "1.2.0-rc.1", false},
+		{"*", "0.0.0-alpha", false},
+		{">=1.2.0-0 <2.0.0", "1.2.0-rc.1", true},
+		{">=1.2.0-0 <2.0.0", "1.3.0-rc.1", false},
+		{"~1.2.3-beta.2", "1.2.3-beta.4", true},
+		{"~1.2.3-beta.2", "1.2.3-beta.1", false},
+	}
+	for k := range tests {
+		c, err := core.ParseConstraint(tests[k].expression)
+		if err != nil {
+			t.Fatalf("`%s`: %v", tests[k].expression, err)
+		}
+		v, err := core.Parse(tests[k].version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual := c.Check(v); actual != tests[k].expected {
+			t.Fatalf("expected `%s` for `%s` to be %v", tests[k].expression, tests[k].version, tests[k].expected)
+		}
+	}
+}
+
+func TestParseConstraintErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   error
+	}{
+		{"", core.ErrInvalidConstraint},
+		{">=1.0.0 ||", core.ErrInvalidConstraint},
+		{">x", core.ErrInvalidConstraint},
+		{"!=1.2", core.ErrInvalidConstraint},
+		{">=1.2.3.4", core.ErrInvalid},
+		{">=v1.2.3", core.ErrInvalid},
+		{"1.2.3-", core.ErrInvalid},
+		{"99999999999999999999.x", core.ErrOverflow},
+	}
+	for k := range tests {
+		if _, err := core.ParseConstraint(tests[k].expression); err != tests[k].expected {
+			t.Fatalf("expected `%s` to fail with %v, got %v", tests[k].expression, tests[k].expected, err)
+		}
+	}
+}
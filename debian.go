@@ -0,0 +1,119 @@
+package semver
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	reDebianUpstream = regexp.MustCompile(`^[0-9][A-Za-z0-9.+~:-]*$`)
+	reDebianRevision = regexp.MustCompile(`^[A-Za-z0-9.+~]+$`)
+)
+
+type debianVersion struct {
+	epoch    string
+	upstream string
+	revision string
+}
+
+func splitDebian(version string) (*debianVersion, bool) {
+	v := &debianVersion{upstream: version}
+	if i := strings.IndexByte(v.upstream, ':'); i >= 0 {
+		v.epoch = v.upstream[:i]
+		v.upstream = v.upstream[i+1:]
+		if !isNumeric(v.epoch) {
+			return nil, false
+		}
+	}
+	if i := strings.LastIndexByte(v.upstream, '-'); i >= 0 {
+		v.revision = v.upstream[i+1:]
+		v.upstream = v.upstream[:i]
+		if !reDebianRevision.MatchString(v.revision) {
+			return nil, false
+		}
+	}
+	if !reDebianUpstream.MatchString(v.upstream) {
+		return nil, false
+	}
+	// Colons are only allowed in the upstream version when there's an epoch.
+	if v.epoch == "" && strings.Contains(v.upstream, ":") {
+		return nil, false
+	}
+	return v, true
+}
+
+func validDebian(version string) bool {
+	_, ok := splitDebian(version)
+	return ok
+}
+
+// compareDebian compares two valid Debian versions by their epoch, upstream version and revision.
+func compareDebian(a string, b string) int {
+	vA, _ := splitDebian(a)
+	vB, _ := splitDebian(b)
+	if c := compareNumericStrings(vA.epoch, vB.epoch); c != 0 {
+		return c
+	}
+	if c := compareDebianPart(vA.upstream, vB.upstream); c != 0 {
+		return c
+	}
+	return compareDebianPart(vA.revision, vB.revision)
+}
+
+// compareDebianPart compares an upstream version or revision like dpkg's verrevcmp. The parts are
+// compared by alternating runs of non-digits and digits. In non-digit runs letters sort before
+// other characters and `~` sorts before anything, even the end of the part.
+func compareDebianPart(a string, b string) int {
+	var i, j int
+	for i < len(a) || j < len(b) {
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			if c := compareInts(debianOrder(a, i), debianOrder(b, j)); c != 0 {
+				return c
+			}
+			i++
+			j++
+		}
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		var firstDiff int
+		for i < len(a) && j < len(b) && isDigit(a[i]) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = compareInts(int(a[i]), int(b[j]))
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return firstDiff
+		}
+	}
+	return 0
+}
+
+func debianOrder(s string, i int) int {
+	if i >= len(s) || isDigit(s[i]) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		return int(c)
+	case c == '~':
+		return -1
+	}
+	return int(c) + 256
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
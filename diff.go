@@ -0,0 +1,58 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// diffKinds maps the change kinds of a compatibility report to the bump they require.
+var diffKinds = map[string]Bump{
+	"breaking": BumpMajor,
+	"feature":  BumpMinor,
+	"patch":    BumpPatch,
+}
+
+// BumpFromDiff returns the lowest version after current that complies with the changes of a
+// compatibility report, like apidiff's. Every change is one of `breaking`, `feature` or `patch`.
+// Before 1.0.0 breaking changes only require a minor bump. When current is a prerelease that already
+// is of the required bump, like `2.0.0-rc.1` for breaking changes, it's promoted to its release.
+func (s *Semver) BumpFromDiff(current string, changes []string) (string, error) {
+	v, err := s.Parse(current)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	required := BumpNone
+	for k := range changes {
+		bump, ok := diffKinds[changes[k]]
+		if !ok {
+			return "", errors.Errorf("change `%s` should be `breaking`, `feature` or `patch`", changes[k])
+		}
+		if bump > required {
+			required = bump
+		}
+	}
+	if required == BumpNone {
+		return "", errors.Errorf("there are no changes that require a release after `%s`", current)
+	}
+	if v.Major == 0 && required == BumpMajor {
+		required = BumpMinor
+	}
+	if v.Prerelease != "" && prereleaseOf(v, required) {
+		return (&Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Patch: v.Patch}).canonical(), nil
+	}
+	next, err := v.increment(required)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return next.canonical(), nil
+}
+
+// prereleaseOf checks if releasing the prerelease's core is a bump of at least the given magnitude.
+func prereleaseOf(v *Version, bump Bump) bool {
+	switch bump {
+	case BumpMajor:
+		return v.Minor == 0 && v.Patch == 0
+	case BumpMinor:
+		return v.Patch == 0
+	}
+	return true
+}
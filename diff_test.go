@@ -0,0 +1,70 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestBumpFromDiff(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		current  string
+		changes  []string
+		expected string
+	}{
+		{"1.2.3", []string{"patch"}, "1.2.4"},
+		{"1.2.3", []string{"patch", "feature"}, "1.3.0"},
+		{"1.2.3+build.5", []string{"feature", "breaking", "patch"}, "2.0.0"},
+		{"0.4.1", []string{"breaking"}, "0.5.0"},
+		{"2.0.0-rc.1", []string{"breaking"}, "2.0.0"},
+		{"1.3.0-rc.1", []string{"breaking"}, "2.0.0"},
+		{"1.3.0-rc.1", []string{"feature"}, "1.3.0"},
+		{"1.2.4-rc.1", []string{"feature"}, "1.3.0"},
+		{"1.2.4-rc.1", []string{"patch"}, "1.2.4"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.current, func(t2 *testing.T) {
+			result, err := s.BumpFromDiff(c.current, c.changes)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if result != c.expected {
+				t2.Fatalf("expected `%s` for %v, got `%s`", c.expected, c.changes, result)
+			}
+		})
+	}
+}
+
+func TestBumpFromDiffErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name    string
+		current string
+		changes []string
+	}{
+		{"invalid version", "1.2", []string{"patch"}},
+		{"unknown change", "1.2.3", []string{"refactor"}},
+		{"no changes", "1.2.3", nil},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			if _, err := s.BumpFromDiff(c.current, c.changes); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+	_, err = s.BumpFromDiff("9223372036854775807.0.0", []string{"breaking"})
+	if _, ok := errors.Cause(err).(*semver.OverflowError); !ok {
+		t.Fatalf("expected an *OverflowError as cause, got %v", err)
+	}
+}
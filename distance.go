@@ -0,0 +1,15 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// Distance returns the difference between the major, minor and patch parts of b compared to a.
+// A positive delta means b is ahead of a for that part, a negative delta means it's behind.
+func (s *Semver) Distance(a, b string) (majorDelta, minorDelta, patchDelta int, err error) {
+	semA, semB, err := s.buildPair(a, b)
+	if err != nil {
+		return 0, 0, 0, errors.Trace(err)
+	}
+	return semB.major - semA.major, semB.minor - semA.minor, semB.revision - semA.revision, nil
+}
@@ -0,0 +1,54 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var distanceVersions = []struct {
+	a     string
+	b     string
+	major int
+	minor int
+	patch int
+}{
+	{"1.2.3", "1.2.3", 0, 0, 0},
+	{"1.2.3", "1.5.3", 0, 3, 0},
+	{"1.5.3", "1.2.3", 0, -3, 0},
+	{"1.2.3", "3.0.0", 2, -2, -3},
+	{"1.2.3-rc.1", "1.2.4", 0, 0, 1},
+	{"1.2.3+build.5", "1.2.9+build.1", 0, 0, 6},
+}
+
+func TestDistance(t *testing.T) {
+	for k := range distanceVersions {
+		d := distanceVersions[k]
+		t.Run("distance-"+d.a+"_"+d.b, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			major, minor, patch, err := semver.Distance(d.a, d.b)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if major != d.major || minor != d.minor || patch != d.patch {
+				t2.Fatalf("expected distance `%d.%d.%d`, got `%d.%d.%d`", d.major, d.minor, d.patch, major, minor, patch)
+			}
+		})
+	}
+}
+
+func TestDistanceErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := semver.Distance(invalidVersions[0], "1.0.0"); err == nil {
+		t.Fatal("expected an error for an invalid first version")
+	}
+	if _, _, _, err := semver.Distance("1.0.0", invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid second version")
+	}
+}
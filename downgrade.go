@@ -0,0 +1,59 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// IsDowngrade validates both versions and checks if the candidate has a lower precedence than the
+// current version.
+func (s *Semver) IsDowngrade(current string, candidate string) (bool, error) {
+	c, err := s.compare(candidate, current)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return c < 0, nil
+}
+
+// DowngradeGuard refuses downgrades, except to the versions that satisfy one of its allowed
+// constraints, like a known good release to roll back to.
+type DowngradeGuard struct {
+	semver  *Semver
+	allowed []*Constraint
+}
+
+// NewDowngradeGuard returns a new instance of DowngradeGuard that doesn't allow any downgrade.
+func NewDowngradeGuard(semver *Semver) *DowngradeGuard {
+	return &DowngradeGuard{semver: semver}
+}
+
+// Allow allows downgrades to the versions that satisfy the constraint expression.
+func (g *DowngradeGuard) Allow(expression string) error {
+	constraint, err := g.semver.ParseConstraint(expression)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	g.allowed = append(g.allowed, constraint)
+	return nil
+}
+
+// GuardDowngrade fails with a *DowngradeError when the candidate is a downgrade from the current version
+// that isn't allowed.
+func (g *DowngradeGuard) GuardDowngrade(current string, candidate string) error {
+	downgrade, err := g.semver.IsDowngrade(current, candidate)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !downgrade {
+		return nil
+	}
+	v, err := g.semver.Parse(candidate)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for k := range g.allowed {
+		if g.allowed[k].CheckVersion(v) {
+			return nil
+		}
+	}
+	return errors.Trace(&DowngradeError{Current: current, Candidate: candidate})
+}
@@ -0,0 +1,82 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestIsDowngrade(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		current   string
+		candidate string
+		downgrade bool
+	}{
+		{"1.2.3", "1.2.2", true},
+		{"1.2.3", "1.2.3-rc.1", true},
+		{"1.2.3", "1.2.3+build.2", false},
+		{"1.2.3", "1.2.3", false},
+		{"1.2.3", "2.0.0", false},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.current+" "+c.candidate, func(t2 *testing.T) {
+			downgrade, err := s.IsDowngrade(c.current, c.candidate)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if downgrade != c.downgrade {
+				t2.Fatalf("expected %t, got %t", c.downgrade, downgrade)
+			}
+		})
+	}
+	if _, err := s.IsDowngrade("1.2", "1.2.0"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
+
+func TestDowngradeGuard(t *testing.T) {
+	s := semver.MustNew()
+	guard := semver.NewDowngradeGuard(s)
+	if err := guard.Allow("=1.4.2 || >=2.0.0 <2.1.0"); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		current   string
+		candidate string
+		refused   bool
+	}{
+		{"1.5.0", "1.6.0", false},
+		{"1.5.0", "1.4.2", false},
+		{"1.5.0", "1.4.1", true},
+		{"2.1.3", "2.0.7", false},
+		{"2.1.3", "2.1.2", true},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.current+" "+c.candidate, func(t2 *testing.T) {
+			err := guard.GuardDowngrade(c.current, c.candidate)
+			if !c.refused {
+				if err != nil {
+					t2.Fatal(err)
+				}
+				return
+			}
+			downgradeErr, ok := errors.Cause(err).(*semver.DowngradeError)
+			if !ok {
+				t2.Fatalf("expected a *DowngradeError, got %v", err)
+			}
+			if downgradeErr.Current != c.current || downgradeErr.Candidate != c.candidate {
+				t2.Fatalf("expected `%s` and `%s`, got %+v", c.current, c.candidate, downgradeErr)
+			}
+		})
+	}
+	if err := guard.Allow(">=1.a"); err == nil {
+		t.Fatal("expected an error for an invalid constraint")
+	}
+	if err := guard.GuardDowngrade("1.5.0", "1.4"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
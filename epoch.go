@@ -0,0 +1,27 @@
+package semver
+
+import (
+	"strings"
+)
+
+// WithEpoch allows versions to be prefixed by an epoch, like dpkg and rpm do with `2:1.4.0`. The epoch
+// is ordered before the major, so it can be raised when a project's upstream versioning was reset.
+// A missing epoch is 0, which isn't rendered in the canonical notation.
+func WithEpoch() Option {
+	return func(s *Semver) error {
+		s.epoch = true
+		return nil
+	}
+}
+
+// splitEpoch splits the epoch off the version when epochs are allowed.
+func (s *Semver) splitEpoch(version string) (string, string, bool) {
+	if !s.epoch {
+		return "", version, false
+	}
+	i := strings.IndexByte(version, ':')
+	if i < 0 {
+		return "", version, false
+	}
+	return version[:i], version[i+1:], true
+}
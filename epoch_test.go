@@ -0,0 +1,163 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestWithEpochValid(t *testing.T) {
+	plain, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := semver.New(semver.WithEpoch())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		version string
+		plain   bool
+		epoch   bool
+	}{
+		{"1.4.0", true, true},
+		{"2:1.4.0", false, true},
+		{"0:1.4.0-rc.1+build", false, true},
+		{"02:1.4.0", false, false},
+		{":1.4.0", false, false},
+		{"a:1.4.0", false, false},
+		{"1:2:1.4.0", false, false},
+		{"99999999999999999999:1.4.0", false, false},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version, func(t2 *testing.T) {
+			if result := plain.Valid(c.version); result != c.plain {
+				t2.Fatalf("expected validity without epochs to be %v, got %v", c.plain, result)
+			}
+			if result := s.Valid(c.version); result != c.epoch {
+				t2.Fatalf("expected validity with epochs to be %v, got %v", c.epoch, result)
+			}
+		})
+	}
+}
+
+func TestWithEpochCompare(t *testing.T) {
+	s, err := semver.New(semver.WithEpoch())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1:0.1.0", "9.9.9", 1},
+		{"2:1.4.0", "1:2.0.0", 1},
+		{"0:1.4.0", "1.4.0", 0},
+		{"1:1.4.0-rc.1", "1:1.4.0", -1},
+	}
+	for k := range cases {
+		c := cases[k]
+		a, err := s.Parse(c.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := s.Parse(c.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := a.Compare(b); result != c.expected {
+			t.Fatalf("expected comparing `%s` to `%s` to be %d, got %d", c.a, c.b, c.expected, result)
+		}
+	}
+}
+
+func TestWithEpochParse(t *testing.T) {
+	s, err := semver.New(semver.WithEpoch())
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, err := s.Parse("2:1.4.0-rc.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version.Epoch != 2 || version.Major != 1 || version.Minor != 4 {
+		t.Fatalf("expected epoch 2 and core 1.4, got %d and %d.%d", version.Epoch, version.Major, version.Minor)
+	}
+	if result := version.String(); result != "2:1.4.0-rc.1" {
+		t.Fatalf("expected `2:1.4.0-rc.1`, got `%s`", result)
+	}
+	version, err = s.Parse("0:1.4.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := version.String(); result != "1.4.0" {
+		t.Fatalf("expected `1.4.0`, got `%s`", result)
+	}
+
+	constraint, err := s.ParseConstraint("^1:1.2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for version, expected := range map[string]bool{"1:1.9.0": true, "1:2.0.0": false, "1.9.0": false, "2:1.2.0": false} {
+		v, err := s.Parse(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := constraint.CheckVersion(v); result != expected {
+			t.Fatalf("expected `%s` to match `^1:1.2.0` to be %v, got %v", version, expected, result)
+		}
+	}
+
+	_, err = s.Parse("99999999999999999999:1.4.0")
+	if err == nil {
+		t.Fatal("expected an error for an epoch that overflows")
+	}
+	if overflow, ok := errors.Cause(err).(*semver.OverflowError); !ok || overflow.Part != "epoch" {
+		t.Fatalf("expected an epoch *OverflowError as cause, got %v", err)
+	}
+}
+
+func TestWithEpochRange(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch())
+	greater, err := s.GreaterThanOrEqual("2:1.0.0", "1:2.0.0")
+	if err != nil || !greater {
+		t.Fatalf("expected `2:1.0.0` to be greater than `1:2.0.0`, got %v, %v", greater, err)
+	}
+	smaller, err := s.SmallerThanOrEqual("2:1.0.0", "1:2.0.0")
+	if err != nil || smaller {
+		t.Fatalf("expected `2:1.0.0` not to be smaller than `1:2.0.0`, got %v, %v", smaller, err)
+	}
+	inRange, err := s.InRange("2:1.0.0", "1:2.0.0", "2:1.0.0")
+	if err != nil || !inRange {
+		t.Fatalf("expected `2:1.0.0` to be in range, got %v, %v", inRange, err)
+	}
+	if inRange, err := s.InRange("1:9.0.0", "2:0.0.0", semver.Unbounded); err != nil || inRange {
+		t.Fatalf("expected `1:9.0.0` not to be in range, got %v, %v", inRange, err)
+	}
+}
+
+func TestWithEpochText(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch())
+	v := s.MustParse("2:1.4.0-rc.1")
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := semver.NewVersionText(s)
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Version.Epoch != 2 || decoded.Version.Compare(v) != 0 {
+		t.Fatalf("expected `%s` to round-trip, got %+v", text, decoded.Version)
+	}
+	if again, err := decoded.MarshalText(); err != nil || string(again) != string(text) {
+		t.Fatalf("expected `%s`, got `%s` (%v)", text, again, err)
+	}
+	var plain semver.Version
+	if err := plain.UnmarshalText(text); err == nil {
+		t.Fatalf("expected `%s` not to decode without epochs", text)
+	}
+}
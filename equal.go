@@ -0,0 +1,39 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// Equal validates both versions and checks if they have the same precedence, which ignores build
+// metadata like the spec says, so `1.2.3+a` equals `1.2.3+b`, unless Options.StrictBuildMeta is set.
+func (s *Semver) Equal(a string, b string) (bool, error) {
+	c, err := s.compare(a, b)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return c == 0, nil
+}
+
+// StrictEqual validates both versions and checks if they're the same after normalization, which includes
+// the build metadata, so `1.2.3+a` doesn't equal `1.2.3+b`, but `0:1.2.3` equals `1.2.3` with WithEpoch.
+func (s *Semver) StrictEqual(a string, b string) (bool, error) {
+	va, err := s.Parse(a)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	vb, err := s.Parse(b)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return va.StrictEqual(vb), nil
+}
+
+// Equal checks if the version has the same precedence as o, ignoring build metadata.
+func (v *Version) Equal(o *Version) bool {
+	return v.Compare(o) == 0
+}
+
+// StrictEqual checks if the version is the same as o after normalization, including build metadata.
+func (v *Version) StrictEqual(o *Version) bool {
+	return v.sentinel == o.sentinel && v.canonical() == o.canonical()
+}
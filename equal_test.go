@@ -0,0 +1,66 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestEqual(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch())
+	cases := []struct {
+		a      string
+		b      string
+		equal  bool
+		strict bool
+	}{
+		{"1.2.3", "1.2.3", true, true},
+		{"1.2.3+a", "1.2.3+b", true, false},
+		{"1.2.3+a", "1.2.3+a", true, true},
+		{"1.2.3", "1.2.3+a", true, false},
+		{"0:1.2.3", "1.2.3", true, true},
+		{"1.2.3-rc.1", "1.2.3-rc.1", true, true},
+		{"1.2.3-rc.1", "1.2.3", false, false},
+		{"1.2.3", "1.2.4", false, false},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.a+" "+c.b, func(t2 *testing.T) {
+			equal, err := s.Equal(c.a, c.b)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if equal != c.equal {
+				t2.Fatalf("expected equal %t, got %t", c.equal, equal)
+			}
+			strict, err := s.StrictEqual(c.a, c.b)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if strict != c.strict {
+				t2.Fatalf("expected strict equal %t, got %t", c.strict, strict)
+			}
+		})
+	}
+}
+
+func TestEqualErrors(t *testing.T) {
+	s := semver.MustNew()
+	if _, err := s.Equal("1.2", "1.2.0"); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := s.StrictEqual("1.2.0", "1.2"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestVersionStrictEqualSentinel(t *testing.T) {
+	zero, max := semver.Zero(), semver.Max()
+	v := &semver.Version{}
+	if !v.StrictEqual(&zero) {
+		t.Fatal("expected Zero to equal 0.0.0")
+	}
+	if v.StrictEqual(&max) || !max.StrictEqual(&max) {
+		t.Fatal("expected the Max sentinel to only equal itself")
+	}
+}
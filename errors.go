@@ -0,0 +1,87 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// OverflowError is the cause of errors for versions with a numeric part too big to fit in an int.
+type OverflowError struct {
+	Version string
+	// Part is the name of the part that overflows: `epoch`, `major`, `minor`, `patch` or `revision`.
+	Part  string
+	Value string
+}
+
+func (e *OverflowError) Error() string {
+	return "the " + e.Part + " `" + e.Value + "` of version `" + e.Version + "` is too big"
+}
+
+// LeadingZeroError is the cause of errors for versions with a numeric identifier that has a leading zero,
+// like `01.2.3` or `1.2.3-rc.01`.
+type LeadingZeroError struct {
+	Version string
+	// Part is the name of the part with the leading zero: `epoch`, `major`, `minor`, `patch`, `revision`
+	// or `prerelease`.
+	Part  string
+	Value string
+}
+
+func (e *LeadingZeroError) Error() string {
+	return "the " + e.Part + " `" + e.Value + "` of version `" + e.Version + "` has a leading zero"
+}
+
+// SanitizeError is the cause of errors for input that Sanitize had to clean up. It counts what was found.
+type SanitizeError struct {
+	Input          string
+	InvalidBytes   int
+	ByteOrderMarks int
+	ZeroWidth      int
+	FullWidth      int
+	// Converted is set when the full-width characters were converted to ASCII instead of stripped.
+	Converted bool
+}
+
+func (e *SanitizeError) Error() string {
+	var found []string
+	if e.InvalidBytes > 0 {
+		found = append(found, strconv.Itoa(e.InvalidBytes)+" invalid UTF-8 bytes")
+	}
+	if e.ByteOrderMarks > 0 {
+		found = append(found, strconv.Itoa(e.ByteOrderMarks)+" byte order marks")
+	}
+	if e.ZeroWidth > 0 {
+		found = append(found, strconv.Itoa(e.ZeroWidth)+" zero-width characters")
+	}
+	if e.FullWidth > 0 {
+		action := "stripped"
+		if e.Converted {
+			action = "converted"
+		}
+		found = append(found, strconv.Itoa(e.FullWidth)+" full-width characters ("+action+")")
+	}
+	return "input " + strconv.Quote(e.Input) + " had " + strings.Join(found, ", ")
+}
+
+// DowngradeError is the cause of errors for a candidate version lower than the current version that
+// a DowngradeGuard doesn't allow.
+type DowngradeError struct {
+	Current   string
+	Candidate string
+}
+
+func (e *DowngradeError) Error() string {
+	return "version `" + e.Candidate + "` is a downgrade from `" + e.Current + "`"
+}
+
+// HookPanicError is the cause of errors for a user-provided hook, like an Option or a Validate func,
+// that panicked. The library recovers from the panic, so it never crashes the host process.
+type HookPanicError struct {
+	Hook  string
+	Value interface{}
+}
+
+func (e *HookPanicError) Error() string {
+	return "hook `" + e.Hook + "` panicked: " + fmt.Sprint(e.Value)
+}
@@ -0,0 +1,65 @@
+package semver
+
+import (
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// Step is the outcome of a single comparator while explaining a constraint.
+type Step struct {
+	// Group is the index of the group of the comparator.
+	Group int
+	// Comparator is nil for the step that rejects a prerelease for the whole group, see
+	// WithIncludePrerelease.
+	Comparator *Comparator
+	Passed     bool
+}
+
+func (s Step) String() string {
+	group := " in group " + strconv.Itoa(s.Group+1)
+	if s.Comparator == nil {
+		return "prerelease not allowed" + group
+	}
+	if s.Passed {
+		return s.Comparator.String() + " passed" + group
+	}
+	return s.Comparator.String() + " failed" + group
+}
+
+// Explain checks if the version satisfies the constraint like Check, and returns a step for every
+// comparator of every group, so tools can tell why a version was rejected, like `<1.8.0 failed in
+// group 2`.
+func (c *Constraint) Explain(version string) (bool, []Step, error) {
+	v, err := c.semver.Parse(version)
+	if err != nil {
+		return false, nil, errors.Trace(err)
+	}
+	var steps []Step
+	var satisfied bool
+	for k, group := range c.groups {
+		passed := true
+		if v.Prerelease != "" && c.excludesPrerelease() && !allowsPrerelease(group, v) {
+			steps = append(steps, Step{Group: k})
+			passed = false
+		}
+		for _, comparator := range group {
+			ok := comparator.check(v)
+			steps = append(steps, Step{Group: k, Comparator: comparator, Passed: ok})
+			passed = passed && ok
+		}
+		satisfied = satisfied || passed
+	}
+	return satisfied, steps, nil
+}
+
+// Failed returns the steps that failed.
+func Failed(steps []Step) []Step {
+	var failed []Step
+	for k := range steps {
+		if !steps[k].Passed {
+			failed = append(failed, steps[k])
+		}
+	}
+	return failed
+}
@@ -0,0 +1,67 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestExplain(t *testing.T) {
+	s := semver.MustNew()
+	constraint, err := s.ParseConstraint(">=1.2.0 <1.5.0 || >=1.6.0 <1.8.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, steps, err := constraint.Explain("1.9.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("expected `1.9.0` to be rejected")
+	}
+	expected := []string{
+		">=1.2.0 passed in group 1",
+		"<1.5.0 failed in group 1",
+		">=1.6.0 passed in group 2",
+		"<1.8.0 failed in group 2",
+	}
+	if len(steps) != len(expected) {
+		t.Fatalf("expected %d steps, got %v", len(expected), steps)
+	}
+	for k := range steps {
+		if steps[k].String() != expected[k] {
+			t.Fatalf("expected `%s`, got `%s`", expected[k], steps[k])
+		}
+	}
+	if failed := semver.Failed(steps); len(failed) != 2 || failed[1].Group != 1 {
+		t.Fatalf("expected the failures of both groups, got %v", failed)
+	}
+	ok, steps, err = constraint.Explain("1.7.0")
+	if err != nil || !ok {
+		t.Fatalf("expected `1.7.0` to be accepted, got %t, %v", ok, err)
+	}
+	if failed := semver.Failed(steps); len(failed) != 1 || failed[0].String() != "<1.5.0 failed in group 1" {
+		t.Fatalf("unexpected failures %v", failed)
+	}
+	if _, _, err := constraint.Explain("1.7"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
+
+func TestExplainPrerelease(t *testing.T) {
+	s := semver.MustNew()
+	constraint, err := s.ParseConstraint(">=1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, steps, err := constraint.Explain("1.2.0-rc.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Fatal("expected the prerelease to be rejected")
+	}
+	if failed := semver.Failed(steps); len(failed) != 1 || failed[0].String() != "prerelease not allowed in group 1" {
+		t.Fatalf("unexpected failures %v", failed)
+	}
+}
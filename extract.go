@@ -0,0 +1,61 @@
+package semver
+
+import (
+	"regexp"
+	"strings"
+)
+
+// reCandidate matches anything that looks like a version, which is validated afterwards. It's greedy,
+// so `1.2.3.4` is a single candidate instead of containing `1.2.3`.
+var reCandidate = regexp.MustCompile(`\d+(?::\d+)?(?:\.\d+)*(?:-[0-9A-Za-z.-]*)?(?:\+[0-9A-Za-z.-]*)?`)
+
+// ExtractAll returns the valid versions in the text, like CLI output or a user agent, in the order they
+// appear. A version has to stand on its own, so it can't be directly preceded by a letter, digit, `.` or
+// `+`, except for a `v` prefix like in `v1.2.3`, which isn't part of the returned version, and it can't be
+// directly followed by a letter. Trailing dots and dashes, like ending a sentence, are dropped, so
+// `app-1.4.0.tar.gz` has `1.4.0`.
+func (s *Semver) ExtractAll(text string) []string {
+	var versions []string
+	for _, match := range reCandidate.FindAllStringIndex(text, -1) {
+		if version, ok := s.extract(text, match[0], match[1]); ok {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// ExtractFirst returns the first valid version in the text, like ExtractAll.
+func (s *Semver) ExtractFirst(text string) (string, bool) {
+	for _, match := range reCandidate.FindAllStringIndex(text, -1) {
+		if version, ok := s.extract(text, match[0], match[1]); ok {
+			return version, true
+		}
+	}
+	return "", false
+}
+
+func (s *Semver) extract(text string, start int, end int) (string, bool) {
+	if start > 0 {
+		before := text[start-1]
+		if before == 'v' || before == 'V' {
+			start--
+			if start > 0 && isWordByte(text[start-1]) {
+				return "", false
+			}
+		} else if isWordByte(before) || before == '.' || before == '+' {
+			return "", false
+		}
+	}
+	version := strings.TrimRight(text[start:end], ".-+")
+	if end = start + len(version); end < len(text) && isWordByte(text[end]) {
+		return "", false
+	}
+	if version[0] == 'v' || version[0] == 'V' {
+		version = version[1:]
+	}
+	return version, s.Valid(version)
+}
+
+func isWordByte(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
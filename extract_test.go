@@ -0,0 +1,51 @@
+package semver_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestExtractAll(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		text     string
+		expected []string
+	}{
+		{"go version go1.21.5 linux/amd64", nil},
+		{"Docker version 24.0.7, build afdd53b", []string{"24.0.7"}},
+		{"Mozilla/5.0 MyApp/2.4.1-beta.3+sha.5114f85 (Linux)", []string{"2.4.1-beta.3+sha.5114f85"}},
+		{"upgrade from v1.2.3 to V2.0.0.", []string{"1.2.3", "2.0.0"}},
+		{"Upgraded to 1.2.3-rc.1.", []string{"1.2.3-rc.1"}},
+		{"app-1.4.0.tar.gz", []string{"1.4.0"}},
+		{"1.2.3abc", nil},
+		{"app-1.4.0 and app_2.0.0", []string{"1.4.0", "2.0.0"}},
+		{"host 10.0.0.1 runs 3.1.4", []string{"3.1.4"}},
+		{"abc1.2.3 dev1.2.3 x.1.2.3 +1.2.3", nil},
+		{"version 01.2.3 or 1.2", nil},
+		{"(1.0.0)[2.0.0]", []string{"1.0.0", "2.0.0"}},
+		{"no versions here", nil},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.text, func(t2 *testing.T) {
+			versions := s.ExtractAll(c.text)
+			if !reflect.DeepEqual(versions, c.expected) {
+				t2.Fatalf("expected %v, got %v", c.expected, versions)
+			}
+			first, ok := s.ExtractFirst(c.text)
+			if ok != (len(c.expected) > 0) || (ok && first != c.expected[0]) {
+				t2.Fatalf("expected first of %v, got `%s`, %t", c.expected, first, ok)
+			}
+		})
+	}
+}
+
+func TestExtractAllOptions(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch(), semver.WithQuadSegments())
+	expected := []string{"2:1.4.0", "10.0.0.1"}
+	if versions := s.ExtractAll("pkg 2:1.4.0 at 10.0.0.1"); !reflect.DeepEqual(versions, expected) {
+		t.Fatalf("expected %v, got %v", expected, versions)
+	}
+}
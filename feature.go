@@ -0,0 +1,90 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+type feature struct {
+	name       string
+	constraint *Constraint
+}
+
+// Features is a registry of features that are enabled from a minimum version, and optionally up to a
+// maximum version, of the client. Register all features before querying it from multiple goroutines.
+type Features struct {
+	semver   *Semver
+	features []feature
+	indexes  map[string]int
+}
+
+// NewFeatures returns a new, empty instance of Features.
+func NewFeatures(semver *Semver) *Features {
+	return &Features{semver: semver, indexes: map[string]int{}}
+}
+
+// Register adds the feature, which is enabled for versions from min up to, but excluding, max. Either
+// bound can be Unbounded to leave that side open. Bounds are compared by precedence, so prereleases of
+// min don't enable the feature, while prereleases between the bounds do, whatever WithIncludePrerelease
+// says about constraints.
+func (f *Features) Register(name string, min string, max string) error {
+	if _, ok := f.indexes[name]; ok {
+		return errors.AlreadyExistsf("feature `%s`", name)
+	}
+	if min != Unbounded && !f.semver.valid(min) {
+		return errors.Errorf("feature `%s` has min `%s` that is invalid", name, min)
+	}
+	if max != Unbounded && !f.semver.valid(max) {
+		return errors.Errorf("feature `%s` has max `%s` that is invalid", name, max)
+	}
+	expression := string(OperatorAny)
+	switch {
+	case min != Unbounded && max != Unbounded:
+		expression = string(OperatorGreaterThanOrEqual) + min + " " + string(OperatorLessThan) + max
+	case min != Unbounded:
+		expression = string(OperatorGreaterThanOrEqual) + min
+	case max != Unbounded:
+		expression = string(OperatorLessThan) + max
+	}
+	constraint, err := f.semver.ParseConstraint(expression)
+	if err != nil {
+		return errors.Annotatef(err, "feature `%s`", name)
+	}
+	if min != Unbounded && max != Unbounded {
+		if c, err := f.semver.Compare(min, max); err != nil || c >= 0 {
+			return errors.Errorf("feature `%s` has min `%s` that isn't lower than max `%s`", name, min, max)
+		}
+	}
+	f.indexes[name] = len(f.features)
+	f.features = append(f.features, feature{name: name, constraint: constraint})
+	return nil
+}
+
+// Enabled checks if the feature is enabled for the client version. It fails with a NotFound error when
+// the feature isn't registered.
+func (f *Features) Enabled(name string, clientVersion string) (bool, error) {
+	k, ok := f.indexes[name]
+	if !ok {
+		return false, errors.NotFoundf("feature `%s`", name)
+	}
+	v, err := f.semver.Parse(clientVersion)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return f.features[k].constraint.checkVersion(v, false), nil
+}
+
+// EnabledFor returns the names of the features that are enabled for the client version, in the order
+// they were registered.
+func (f *Features) EnabledFor(clientVersion string) ([]string, error) {
+	v, err := f.semver.Parse(clientVersion)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var names []string
+	for k := range f.features {
+		if f.features[k].constraint.checkVersion(v, false) {
+			names = append(names, f.features[k].name)
+		}
+	}
+	return names, nil
+}
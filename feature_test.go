@@ -0,0 +1,95 @@
+package semver_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestFeatures(t *testing.T) {
+	s := semver.MustNew()
+	features := semver.NewFeatures(s)
+	registrations := []struct {
+		name string
+		min  string
+		max  string
+	}{
+		{"streaming", "2.5.0", semver.Unbounded},
+		{"legacy-auth", semver.Unbounded, "3.0.0"},
+		{"batch-v1", "1.2.0", "2.0.0"},
+		{"health", semver.Unbounded, semver.Unbounded},
+	}
+	for _, r := range registrations {
+		if err := features.Register(r.name, r.min, r.max); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cases := []struct {
+		version string
+		enabled []string
+	}{
+		{"1.0.0", []string{"legacy-auth", "health"}},
+		{"1.9.9", []string{"legacy-auth", "batch-v1", "health"}},
+		{"2.5.0-rc.1", []string{"legacy-auth", "health"}},
+		{"2.5.0", []string{"streaming", "legacy-auth", "health"}},
+		{"3.0.0", []string{"streaming", "health"}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version, func(t2 *testing.T) {
+			enabled, err := features.EnabledFor(c.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if !reflect.DeepEqual(enabled, c.enabled) {
+				t2.Fatalf("expected %v, got %v", c.enabled, enabled)
+			}
+			for _, r := range registrations {
+				on, err := features.Enabled(r.name, c.version)
+				if err != nil {
+					t2.Fatal(err)
+				}
+				var expected bool
+				for _, name := range c.enabled {
+					expected = expected || name == r.name
+				}
+				if on != expected {
+					t2.Fatalf("expected `%s` enabled %t, got %t", r.name, expected, on)
+				}
+			}
+		})
+	}
+}
+
+func TestFeaturesErrors(t *testing.T) {
+	s := semver.MustNew()
+	features := semver.NewFeatures(s)
+	if err := features.Register("streaming", "2.5.0", semver.Unbounded); err != nil {
+		t.Fatal(err)
+	}
+	if err := features.Register("streaming", "2.6.0", semver.Unbounded); !errors.IsAlreadyExists(err) {
+		t.Fatalf("expected an AlreadyExists error, got %v", err)
+	}
+	if err := features.Register("inverted", "2.0.0", "1.0.0"); err == nil {
+		t.Fatal("expected an error for min above max")
+	}
+	if err := features.Register("invalid", "2.a", semver.Unbounded); err == nil {
+		t.Fatal("expected an error for an invalid min")
+	}
+	for _, bounds := range [][2]string{{"1.0.0 || <0.5.0", semver.Unbounded}, {"1.0.0", "2.0.0 || >=3.0.0"}, {"^1.0.0", "2.0.0"}} {
+		if err := features.Register("expression", bounds[0], bounds[1]); err == nil {
+			t.Fatalf("expected an error for the bounds %v", bounds)
+		}
+	}
+	if _, err := features.Enabled("unknown", "1.0.0"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+	if _, err := features.Enabled("streaming", "1.0"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := features.EnabledFor("1.0"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
@@ -0,0 +1,12 @@
+package semver
+
+// WithPrereleaseCaseFolding folds the case of prerelease identifiers, so `1.0.0-RC.1` and `1.0.0-rc.1`
+// are the same release for equality and ordering, like some registries treat them. By the spec they
+// differ. Prereleases are lowercased when parsed, so Version.Prerelease, String, Hash and Key are
+// folded as well; Original keeps the notation as it was parsed.
+func WithPrereleaseCaseFolding() Option {
+	return func(s *Semver) error {
+		s.foldCase = true
+		return nil
+	}
+}
@@ -0,0 +1,61 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestWithPrereleaseCaseFolding(t *testing.T) {
+	strict := semver.MustNew()
+	folded := semver.MustNew(semver.WithPrereleaseCaseFolding())
+	cases := []struct {
+		a       string
+		b       string
+		strict  int
+		folding int
+	}{
+		{"1.0.0-RC.1", "1.0.0-rc.1", -1, 0},
+		{"1.0.0-Beta.2", "1.0.0-alpha.3", -1, 1},
+		{"1.0.0-RC.1+Build", "1.0.0-rc.1+build", -1, 0},
+		{"1.0.0-RC.2", "1.0.0-rc.1", -1, 1},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.a+" "+c.b, func(t2 *testing.T) {
+			if result, err := strict.Compare(c.a, c.b); err != nil || result != c.strict {
+				t2.Fatalf("expected strictly %d, got %d (%v)", c.strict, result, err)
+			}
+			if result, err := folded.Compare(c.a, c.b); err != nil || result != c.folding {
+				t2.Fatalf("expected with folding %d, got %d (%v)", c.folding, result, err)
+			}
+		})
+	}
+	v := folded.MustParse("1.0.0-RC.1+Build")
+	if v.Prerelease != "rc.1" || v.Build != "Build" || v.Original() != "1.0.0-RC.1+Build" {
+		t.Fatalf("unexpected parsed version %+v", *v)
+	}
+	if v.Key() != folded.MustParse("1.0.0-rc.1").Key() {
+		t.Fatal("expected folded versions to have equal keys")
+	}
+	constraint, err := folded.ParseConstraint(">=1.0.0-Beta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := constraint.Check("1.0.0-BETA.1"); err != nil || !ok {
+		t.Fatalf("expected `1.0.0-BETA.1` to satisfy `>=1.0.0-Beta`, got %v (%v)", ok, err)
+	}
+}
+
+func TestWithPrereleaseCaseFoldingChannels(t *testing.T) {
+	s, err := semver.New(semver.WithChannelOrder("Dev", "alpha", "RC"), semver.WithPrereleaseCaseFolding())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, err := s.Compare("1.0.0-DEV.4", "1.0.0-Alpha.1"); err != nil || result != -1 {
+		t.Fatalf("expected `dev` to be lower than `alpha`, got %d (%v)", result, err)
+	}
+	if _, err := semver.New(semver.WithChannelOrder("rc", "RC"), semver.WithPrereleaseCaseFolding()); err == nil {
+		t.Fatal("expected an error for channels that are equal when folding case")
+	}
+}
@@ -0,0 +1,66 @@
+package semver
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/juju/errors"
+)
+
+// Format validates the version and renders it by the layout. See Version.Format for the layout.
+func (s *Semver) Format(version string, layout string) (string, error) {
+	v, err := s.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return v.Format(layout), nil
+}
+
+// Format renders the version by the layout, in which these letters are replaced by the version's parts:
+//
+//	M   major
+//	m   minor
+//	p   patch
+//	P   prerelease
+//	B   build metadata
+//
+// Any other character is copied as is; escape a letter with a backslash to copy it too. A character
+// directly in front of an empty prerelease or build is left out, so `vM.m.p-P` renders `v1.2.3` for
+// a stable and `v1.2.3-rc.1` for a prerelease version.
+func (v *Version) Format(layout string) string {
+	out := make([]byte, 0, len(layout)+16)
+	// literal tells if the last written byte is a single-byte literal that may be left out.
+	literal := false
+	for k := 0; k < len(layout); k++ {
+		var part string
+		switch layout[k] {
+		case 'M':
+			part = strconv.Itoa(v.Major)
+		case 'm':
+			part = strconv.Itoa(v.Minor)
+		case 'p':
+			part = strconv.Itoa(v.Patch)
+		case 'P':
+			part = v.Prerelease
+		case 'B':
+			part = v.Build
+		case '\\':
+			if k+1 < len(layout) {
+				k++
+			}
+			out = append(out, layout[k])
+			literal = false
+			continue
+		default:
+			out = append(out, layout[k])
+			literal = layout[k] < utf8.RuneSelf
+			continue
+		}
+		if part == "" && literal {
+			out = out[:len(out)-1]
+		}
+		out = append(out, part...)
+		literal = false
+	}
+	return string(out)
+}
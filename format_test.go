@@ -0,0 +1,57 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var formatVersions = []struct {
+	version  string
+	layout   string
+	expected string
+}{
+	{"1.2.3", "M.m", "1.2"},
+	{"1.2.3", "vM.m.p", "v1.2.3"},
+	{"1.2.3", "M.m.p-P", "1.2.3"},
+	{"1.2.3-rc.1", "M.m.p-P", "1.2.3-rc.1"},
+	{"1.2.3-rc.1+sha.5114f85", "M.m.p-P+B", "1.2.3-rc.1+sha.5114f85"},
+	{"1.2.3+sha.5114f85", "M.m.p-P+B", "1.2.3+sha.5114f85"},
+	{"1.2.3+sha.5114f85", "M.m.p_B", "1.2.3_sha.5114f85"},
+	{"10.20.30", "release-M.m.x", "release-10.20.x"},
+	{"1.2.3", `a\p\p-vM.m.p.tar.gz`, "app-v1.2.3.tar.gz"},
+	{"1.2.3", `\M\m\p M`, "Mmp 1"},
+	{"1.2.3", `M\`, `1\`},
+	{"1.2.3", "P", ""},
+	{"1.2.3", "M.m.péP", "1.2.3é"},
+	{"1.2.3-beta", "MmpP", "123beta"},
+}
+
+func TestFormat(t *testing.T) {
+	for k := range formatVersions {
+		f := formatVersions[k]
+		t.Run("format-"+f.version+"_"+f.layout, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			formatted, err := semver.Format(f.version, f.layout)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if formatted != f.expected {
+				t2.Fatalf("expected `%s`, got `%s`", f.expected, formatted)
+			}
+		})
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.Format(invalidVersions[0], "M.m.p"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
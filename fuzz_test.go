@@ -0,0 +1,160 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/core"
+)
+
+func addVersionSeeds(f *testing.F) {
+	for k := range validVersions {
+		f.Add(validVersions[k])
+	}
+	for k := range invalidVersions {
+		f.Add(invalidVersions[k])
+	}
+	f.Add("99999999999999999999.0.0")
+	f.Add("1.0.0-rc.99999999999999999999")
+	f.Add("1.0.0+build.meta-data")
+	f.Add("v0.0.0-20230101120000-abcdef123456")
+}
+
+func FuzzValid(f *testing.F) {
+	addVersionSeeds(f)
+	s, err := semver.New()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Fuzz(func(t *testing.T, version string) {
+		v, err := s.Parse(version)
+		if s.Valid(version) != (err == nil) {
+			t.Fatalf("Valid and Parse disagree on `%s`: %v", version, err)
+		}
+		if core.Valid(version) != (err == nil) {
+			t.Fatalf("the core package and Parse disagree on `%s`: %v", version, err)
+		}
+		if err != nil {
+			return
+		}
+		if c := v.Compare(v); c != 0 {
+			t.Fatalf("expected `%s` to equal itself, got %d", version, c)
+		}
+		if _, _, _, err := s.Distance(version, version); err != nil {
+			t.Fatalf("expected the distance of valid `%s` to succeed: %v", version, err)
+		}
+		_, _ = s.ParsePseudo(version)
+		_, _ = s.ParsePseudo("v" + version)
+	})
+}
+
+func FuzzCompare(f *testing.F) {
+	for k := range greaterThanVersions {
+		f.Add(greaterThanVersions[k][0], greaterThanVersions[k][1])
+	}
+	f.Add("1.0.0-alpha.1", "1.0.0-alpha.beta")
+	f.Add("1.0.0-2", "1.0.0-10")
+	f.Add("1.0.0+a", "1.0.0+b")
+	s, err := semver.New()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Fuzz(func(t *testing.T, a string, b string) {
+		greaterThanOrEqual, errGreater := s.GreaterThanOrEqual(a, b)
+		smallerThanOrEqual, errSmaller := s.SmallerThanOrEqual(b, a)
+		if (errGreater == nil) != (errSmaller == nil) {
+			t.Fatalf("GreaterThanOrEqual and SmallerThanOrEqual disagree on erroring: %v, %v", errGreater, errSmaller)
+		}
+		if greaterThanOrEqual != smallerThanOrEqual {
+			t.Fatalf("expected `%s` >= `%s` to mirror `%s` <= `%s`", a, b, b, a)
+		}
+		vA, errA := s.Parse(a)
+		vB, errB := s.Parse(b)
+		if errA != nil || errB != nil {
+			if errGreater == nil {
+				t.Fatalf("expected comparing invalid versions `%s` and `%s` to fail", a, b)
+			}
+			return
+		}
+		if vA.Compare(vB) != -vB.Compare(vA) {
+			t.Fatalf("expected comparing `%s` and `%s` to be antisymmetric", a, b)
+		}
+		if semver.CompareStrings(a, b) != vA.Compare(vB) {
+			t.Fatalf("expected CompareStrings to agree with Compare on `%s` and `%s`", a, b)
+		}
+	})
+}
+
+func FuzzInRange(f *testing.F) {
+	for k := range inRangeVersions {
+		f.Add(inRangeVersions[k][0], inRangeVersions[k][1], inRangeVersions[k][2])
+	}
+	for k := range outOfRangeVersions {
+		f.Add(outOfRangeVersions[k][0], outOfRangeVersions[k][1], outOfRangeVersions[k][2])
+	}
+	s, err := semver.New()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Fuzz(func(t *testing.T, version string, start string, end string) {
+		inRange, err := s.InRange(version, start, end)
+		if err != nil {
+			return
+		}
+		if !inRange {
+			return
+		}
+		if start != semver.Unbounded {
+			greaterThanOrEqual, err := s.GreaterThanOrEqual(version, start)
+			if err != nil || !greaterThanOrEqual {
+				t.Fatalf("expected `%s` in range to be >= `%s`", version, start)
+			}
+		}
+		if end != semver.Unbounded {
+			smallerThanOrEqual, err := s.SmallerThanOrEqual(version, end)
+			if err != nil || !smallerThanOrEqual {
+				t.Fatalf("expected `%s` in range to be <= `%s`", version, end)
+			}
+		}
+	})
+}
+
+func FuzzConstraint(f *testing.F) {
+	for k := range constraintChecks {
+		f.Add(constraintChecks[k].constraint, constraintChecks[k].version)
+	}
+	for k := range invalidConstraints {
+		f.Add(invalidConstraints[k], "1.0.0")
+	}
+	s, err := semver.New()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Fuzz(func(t *testing.T, expression string, version string) {
+		constraint, err := s.ParseConstraint(expression)
+		if err != nil {
+			return
+		}
+		reparsed, err := s.ParseConstraint(constraint.String())
+		if err != nil {
+			t.Fatalf("expected `%s` from `%s` to parse: %v", constraint.String(), expression, err)
+		}
+		if reparsed.String() != constraint.String() {
+			t.Fatalf("expected `%s` to round-trip, got `%s`", constraint.String(), reparsed.String())
+		}
+		result, err := constraint.Check(version)
+		if err != nil {
+			return
+		}
+		if reparsedResult, _ := reparsed.Check(version); reparsedResult != result {
+			t.Fatalf("expected the reparsed `%s` to agree on `%s`", constraint.String(), version)
+		}
+		coreConstraint, err := core.ParseConstraint(expression)
+		if err != nil {
+			return
+		}
+		if coreVersion, err := core.Parse(version); err == nil && coreConstraint.Check(coreVersion) != result {
+			t.Fatalf("the core package and Check disagree on `%s` for `%s`", expression, version)
+		}
+	})
+}
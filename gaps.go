@@ -0,0 +1,70 @@
+package semver
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+)
+
+// MissingPatches reports the holes in a release sequence: for every released minor, the patches from
+// `.0` up to the highest released patch that weren't released, so `1.2.0` and `1.2.2` miss `1.2.1`.
+// Prereleases don't count as releases and build metadata is ignored. The missing versions are
+// returned in ascending order. At most limit versions are reported; more fail with an error.
+func (s *Semver) MissingPatches(versions []string, limit int) ([]string, error) {
+	type minor struct {
+		epoch int
+		major int
+		minor int
+	}
+	released := map[minor]map[int]bool{}
+	highest := map[minor]int{}
+	for k := range versions {
+		v, err := s.Parse(versions[k])
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if v.Prerelease != "" {
+			continue
+		}
+		key := minor{epoch: v.Epoch, major: v.Major, minor: v.Minor}
+		if released[key] == nil {
+			released[key] = map[int]bool{}
+		}
+		released[key][v.Patch] = true
+		if v.Patch > highest[key] {
+			highest[key] = v.Patch
+		}
+	}
+	minors := make([]minor, 0, len(released))
+	for key := range released {
+		minors = append(minors, key)
+	}
+	sort.Slice(minors, func(i, j int) bool {
+		if minors[i].epoch != minors[j].epoch {
+			return minors[i].epoch < minors[j].epoch
+		}
+		if minors[i].major != minors[j].major {
+			return minors[i].major < minors[j].major
+		}
+		return minors[i].minor < minors[j].minor
+	})
+	var count int
+	for _, key := range minors {
+		// The highest patch is released, so every other patch below it that isn't is missing.
+		missing := highest[key] - (len(released[key]) - 1)
+		if missing > limit-count {
+			return nil, errors.Errorf("versions miss more than %d patches", limit)
+		}
+		count += missing
+	}
+	var missing []string
+	for _, key := range minors {
+		for patch := 0; patch < highest[key]; patch++ {
+			if !released[key][patch] {
+				v := &Version{Epoch: key.epoch, Major: key.major, Minor: key.minor, Patch: patch}
+				missing = append(missing, v.canonical())
+			}
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,60 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestMissingPatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		versions []string
+		missing  string
+	}{
+		{"none", []string{"1.2.0", "1.2.1", "1.2.2"}, ""},
+		{"single", []string{"1.2.0", "1.2.2"}, "1.2.1"},
+		{"from-zero", []string{"1.2.3"}, "1.2.0,1.2.1,1.2.2"},
+		{"unordered", []string{"2.0.2", "1.0.1", "2.0.0", "1.0.0"}, "2.0.1"},
+		{"minors", []string{"1.1.0", "1.1.2", "1.0.0", "1.0.2", "0.9.1"}, "0.9.0,1.0.1,1.1.1"},
+		{"prereleases", []string{"1.2.0", "1.2.1-rc.1", "1.2.2"}, "1.2.1"},
+		{"build-metadata", []string{"1.2.0+build.1", "1.2.1+build.2"}, ""},
+		{"empty", nil, ""},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run("missing-patches-"+c.name, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			missing, err := semver.MissingPatches(c.versions, 10)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if strings.Join(missing, ",") != c.missing {
+				t2.Fatalf("expected `%s` to be missing, got `%s`", c.missing, strings.Join(missing, ","))
+			}
+		})
+	}
+}
+
+func TestMissingPatchesErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.MissingPatches([]string{"1.0.0", invalidVersions[0]}, 10); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := semver.MissingPatches([]string{"1.0.9223372036854775807"}, 1000); err == nil {
+		t.Fatal("expected an error for more missing patches than the limit")
+	}
+	if _, err := semver.MissingPatches([]string{"1.0.5", "1.1.5"}, 9); err == nil {
+		t.Fatal("expected an error for more missing patches than the limit across minors")
+	}
+	if missing, err := semver.MissingPatches([]string{"1.0.5", "1.1.5"}, 10); err != nil || len(missing) != 10 {
+		t.Fatalf("expected 10 missing patches, got %v, %v", missing, err)
+	}
+}
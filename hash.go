@@ -0,0 +1,68 @@
+package semver
+
+import (
+	"hash/fnv"
+	"io"
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// Hash returns a 64-bit FNV-1a hash of the version, which is the same across processes and releases
+// of this package. Build metadata is excluded, so versions with equal precedence have equal hashes.
+func (v *Version) Hash() uint64 {
+	h := fnv.New64a()
+	v.writeHash(h)
+	return h.Sum64()
+}
+
+// Bucket assigns the version to one of the given number of buckets by its Hash, so staged rollouts can
+// key cohorts off the client version. Versions with equal precedence always land in the same bucket.
+func (s *Semver) Bucket(version string, buckets int) (int, error) {
+	if buckets <= 0 {
+		return 0, errors.Errorf("buckets %d should be positive", buckets)
+	}
+	v, err := s.Parse(version)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return int(v.Hash() % uint64(buckets)), nil
+}
+
+func (v *Version) writeHash(w io.Writer) {
+	if v.sentinel == sentinelMax {
+		_, _ = io.WriteString(w, "\x00max")
+		return
+	}
+	if v.Epoch != 0 {
+		_, _ = io.WriteString(w, strconv.Itoa(v.Epoch)+":")
+	}
+	_, _ = io.WriteString(w, strconv.Itoa(v.Major)+"."+strconv.Itoa(v.Minor)+"."+strconv.Itoa(v.Patch))
+	if v.Revision != 0 {
+		_, _ = io.WriteString(w, "."+strconv.Itoa(v.Revision))
+	}
+	if v.Prerelease != "" {
+		_, _ = io.WriteString(w, "-"+v.Prerelease)
+	}
+}
+
+// Hash returns a 64-bit FNV-1a hash of the constraint, which is the same across processes and releases
+// of this package. Like String, it's based on the normalized expression, but without build metadata.
+func (c *Constraint) Hash() uint64 {
+	h := fnv.New64a()
+	for k := range c.groups {
+		if k > 0 {
+			_, _ = io.WriteString(h, " || ")
+		}
+		for i, comparator := range c.groups[k] {
+			if i > 0 {
+				_, _ = io.WriteString(h, " ")
+			}
+			_, _ = io.WriteString(h, string(comparator.Operator))
+			if comparator.Version != nil {
+				comparator.Version.writeHash(h)
+			}
+		}
+	}
+	return h.Sum64()
+}
@@ -0,0 +1,117 @@
+package semver_test
+
+import (
+	"hash/fnv"
+	"strconv"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestVersionHash(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(version string) *semver.Version {
+		v, err := s.Parse(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("1.2.3-rc.1"))
+	if hash := parse("1.2.3-rc.1+build.5").Hash(); hash != h.Sum64() {
+		t.Fatalf("expected the FNV-1a hash of `1.2.3-rc.1`, got %d", hash)
+	}
+	if parse("1.2.3+a").Hash() != parse("1.2.3+b").Hash() {
+		t.Fatal("expected build metadata to be excluded")
+	}
+	max := semver.Max()
+	hashes := map[uint64]string{}
+	for _, v := range []*semver.Version{parse("1.2.3"), parse("1.2.3-rc.1"), parse("1.2.4"), parse("0.0.0"),
+		&max, parse(max.Original())} {
+		if other, ok := hashes[v.Hash()]; ok {
+			t.Fatalf("expected `%s` and `%s` to hash differently", v, other)
+		}
+		hashes[v.Hash()] = v.String()
+	}
+}
+
+func TestConstraintHash(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := func(expression string) uint64 {
+		c, err := s.ParseConstraint(expression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c.Hash()
+	}
+	for _, equal := range [][2]string{
+		{">=1.2.0 <2.0.0", ">= 1.2.0, <2.0.0"},
+		{"=1.2.3", "1.2.3+build"},
+		{"^1.0.0||*", "^1.0.0 || *"},
+	} {
+		if hash(equal[0]) != hash(equal[1]) {
+			t.Fatalf("expected `%s` and `%s` to hash equally", equal[0], equal[1])
+		}
+	}
+	for _, different := range [][2]string{
+		{">=1.2.0 <2.0.0", ">=1.2.0 || <2.0.0"},
+		{"~1.2.0", "^1.2.0"},
+		{"1.2.3", "1.2.3-rc.1"},
+	} {
+		if hash(different[0]) == hash(different[1]) {
+			t.Fatalf("expected `%s` and `%s` to hash differently", different[0], different[1])
+		}
+	}
+}
+
+func TestBucket(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("1.2.3-rc.1"))
+	expected := int(h.Sum64() % 10)
+	for _, version := range []string{"1.2.3-rc.1", "1.2.3-rc.1+build.5"} {
+		bucket, err := s.Bucket(version, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bucket != expected {
+			t.Fatalf("expected `%s` in bucket %d, got %d", version, expected, bucket)
+		}
+	}
+	counts := make([]int, 4)
+	for patch := 0; patch < 400; patch++ {
+		bucket, err := s.Bucket("1.0."+strconv.Itoa(patch), len(counts))
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[bucket]++
+	}
+	for k := range counts {
+		if counts[k] < 50 {
+			t.Fatalf("expected the versions to spread over the buckets, got %v", counts)
+		}
+	}
+}
+
+func TestBucketErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Bucket("1.2.3", 0); err == nil {
+		t.Fatal("expected an error for zero buckets")
+	}
+	if _, err := s.Bucket("1.2", 10); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
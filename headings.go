@@ -0,0 +1,103 @@
+package semver
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// HeadingPolicy configures how ValidateChangelogHeadings checks the version headings of a changelog.
+type HeadingPolicy struct {
+	// Contiguous requires every heading to be the immediate predecessor of the one above it, like
+	// IsImmediateSuccessor checks, so no release is missing from the changelog.
+	Contiguous bool
+	// SkipPrereleases ignores the headings of prereleases, for changelogs that only list them until
+	// their release.
+	SkipPrereleases bool
+}
+
+// HeadingError is a version heading of a changelog that violates the HeadingPolicy.
+type HeadingError struct {
+	// Line is the 1-based line number of the heading.
+	Line    int
+	Heading string
+	Version string
+	// Previous is the version of the heading above it. It's empty for the first heading.
+	Previous string
+	Reason   string
+}
+
+func (e *HeadingError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ": `" + e.Heading + "` " + e.Reason
+}
+
+// ValidateChangelogHeadings reads a Markdown changelog like CHANGELOG.md, newest first, and checks that
+// its version headings are strictly descending and, with Contiguous, don't skip any release. It returns
+// a HeadingError for every heading that violates the policy, including headings with invalid versions.
+// Headings that aren't lower than the one above it are skipped when checking the next heading.
+// Headings are compared by precedence, so build metadata is ignored.
+func (s *Semver) ValidateChangelogHeadings(r io.Reader, policy HeadingPolicy) ([]*HeadingError, error) {
+	if r == nil {
+		return nil, errors.New("reader cannot be nil")
+	}
+	var headingErrors []*HeadingError
+	var previous *Version
+	var previousLine int
+	var line int
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		heading, ok := parseChangelogHeading(text)
+		if !ok {
+			continue
+		}
+		headingErr := &HeadingError{Line: line, Heading: text, Version: heading.version}
+		if previous != nil {
+			headingErr.Previous = previous.Original()
+		}
+		v, err := s.Parse(heading.version)
+		if err != nil {
+			headingErr.Reason = "has an invalid version: " + errors.Cause(err).Error()
+			headingErrors = append(headingErrors, headingErr)
+			continue
+		}
+		if policy.SkipPrereleases && v.Prerelease != "" {
+			continue
+		}
+		if previous != nil {
+			var descending bool
+			headingErr.Reason, descending = checkHeading(v, previous, previousLine, policy)
+			if headingErr.Reason != "" {
+				headingErrors = append(headingErrors, headingErr)
+			}
+			if !descending {
+				// Compare the next heading to the last one in order, so a single misplaced heading is
+				// a single error.
+				continue
+			}
+		}
+		previous, previousLine = v, line
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return headingErrors, nil
+}
+
+// checkHeading returns why the version can't follow the previous heading, or an empty string when it can,
+// and whether it's lower than the previous heading.
+func checkHeading(v *Version, previous *Version, previousLine int, policy HeadingPolicy) (string, bool) {
+	switch c := v.Compare(previous); {
+	case c == 0:
+		return "duplicates the heading on line " + strconv.Itoa(previousLine), false
+	case c > 0:
+		return "is higher than `" + previous.Original() + "` above it", false
+	}
+	if policy.Contiguous && !immediateSuccessor(v, previous) {
+		return "skips the releases between `" + v.Original() + "` and `" + previous.Original() + "`", true
+	}
+	return "", true
+}
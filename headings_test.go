@@ -0,0 +1,89 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+const headingsChangelog = `# Changelog
+
+## [Unreleased]
+
+## [1.3.0] - 2024-03-01
+### Added
+- Things.
+
+## [1.3.0-rc.1] - 2024-02-20
+
+## [1.1.0] - 2024-01-10
+
+## [1.2.0] - 2024-01-20
+
+## [1.1.0] - 2024-01-05
+
+## [1.0.01] - 2023-12-01
+
+## [0.9.0] - 2023-11-01
+
+## 2023-10-01
+`
+
+func TestValidateChangelogHeadings(t *testing.T) {
+	s := semver.MustNew()
+	tests := []struct {
+		name     string
+		policy   semver.HeadingPolicy
+		expected []string
+	}{
+		{"Descending", semver.HeadingPolicy{}, []string{
+			"line 13: `## [1.2.0] - 2024-01-20` is higher than `1.1.0` above it",
+			"line 15: `## [1.1.0] - 2024-01-05` duplicates the heading on line 11",
+			"line 17: `## [1.0.01] - 2023-12-01` has an invalid version: the patch `01` of version `1.0.01` has a leading zero",
+		}},
+		{"Contiguous", semver.HeadingPolicy{Contiguous: true}, []string{
+			"line 11: `## [1.1.0] - 2024-01-10` skips the releases between `1.1.0` and `1.3.0-rc.1`",
+			"line 13: `## [1.2.0] - 2024-01-20` is higher than `1.1.0` above it",
+			"line 15: `## [1.1.0] - 2024-01-05` duplicates the heading on line 11",
+			"line 17: `## [1.0.01] - 2023-12-01` has an invalid version: the patch `01` of version `1.0.01` has a leading zero",
+			"line 19: `## [0.9.0] - 2023-11-01` skips the releases between `0.9.0` and `1.1.0`",
+		}},
+		{"SkipPrereleases", semver.HeadingPolicy{Contiguous: true, SkipPrereleases: true}, []string{
+			"line 11: `## [1.1.0] - 2024-01-10` skips the releases between `1.1.0` and `1.3.0`",
+			"line 13: `## [1.2.0] - 2024-01-20` is higher than `1.1.0` above it",
+			"line 15: `## [1.1.0] - 2024-01-05` duplicates the heading on line 11",
+			"line 17: `## [1.0.01] - 2023-12-01` has an invalid version: the patch `01` of version `1.0.01` has a leading zero",
+			"line 19: `## [0.9.0] - 2023-11-01` skips the releases between `0.9.0` and `1.1.0`",
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t2 *testing.T) {
+			headingErrors, err := s.ValidateChangelogHeadings(strings.NewReader(headingsChangelog), test.policy)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if len(headingErrors) != len(test.expected) {
+				t2.Fatalf("expected %d errors, got %v", len(test.expected), headingErrors)
+			}
+			for k := range headingErrors {
+				if headingErrors[k].Error() != test.expected[k] {
+					t2.Fatalf("expected `%s`, got `%s`", test.expected[k], headingErrors[k].Error())
+				}
+			}
+		})
+	}
+
+	headingErrors, err := s.ValidateChangelogHeadings(strings.NewReader("## 1.2.0\n## 1.0.0\n"), semver.HeadingPolicy{
+		Contiguous: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(headingErrors) != 1 || headingErrors[0].Line != 2 || headingErrors[0].Previous != "1.2.0" {
+		t.Fatalf("expected a skip on line 2 below `1.2.0`, got %v", headingErrors)
+	}
+	if _, err := s.ValidateChangelogHeadings(nil, semver.HeadingPolicy{}); err == nil {
+		t.Fatal("expected an error for a nil reader")
+	}
+}
@@ -0,0 +1,159 @@
+// Package helmchart loads Helm `Chart.yaml` files and checks their dependency constraints against the
+// charts of a repository, so chart repositories can be linted without Helm itself.
+package helmchart
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the file that describes a chart.
+const FileName = "Chart.yaml"
+
+// Chart is the part of a `Chart.yaml` that's about versions.
+type Chart struct {
+	Name         string       `yaml:"name"`
+	Version      string       `yaml:"version"`
+	AppVersion   string       `yaml:"appVersion"`
+	KubeVersion  string       `yaml:"kubeVersion"`
+	Dependencies []Dependency `yaml:"dependencies"`
+	// Path is the file the chart was loaded from, if any.
+	Path string `yaml:"-"`
+}
+
+// Dependency is a chart dependency, of which the version is a constraint like `^1.2.0`.
+type Dependency struct {
+	Name       string `yaml:"name"`
+	Version    string `yaml:"version"`
+	Repository string `yaml:"repository"`
+}
+
+// Unmet is a dependency of a chart that no available version satisfies.
+type Unmet struct {
+	Chart      string
+	Dependency Dependency
+}
+
+// Load decodes the `Chart.yaml` from r and validates its name and version.
+func Load(s *semver.Semver, r io.Reader) (*Chart, error) {
+	chart := &Chart{}
+	if err := yaml.NewDecoder(r).Decode(chart); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if chart.Name == "" {
+		return nil, errors.New("chart has no name")
+	}
+	if !s.Valid(chart.Version) {
+		return nil, errors.Errorf("chart `%s` has invalid version `%s`", chart.Name, chart.Version)
+	}
+	return chart, nil
+}
+
+// LoadFile loads the `Chart.yaml` at the path.
+func LoadFile(s *semver.Semver, path string) (*Chart, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer f.Close()
+	chart, err := Load(s, f)
+	if err != nil {
+		return nil, errors.Annotatef(err, "chart `%s`", path)
+	}
+	chart.Path = path
+	return chart, nil
+}
+
+// LoadDir loads every `Chart.yaml` below the root, in lexical order of their paths.
+func LoadDir(s *semver.Semver, root string) ([]*Chart, error) {
+	var charts []*Chart
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if d.IsDir() || d.Name() != FileName {
+			return nil
+		}
+		chart, err := LoadFile(s, path)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		charts = append(charts, chart)
+		return nil
+	})
+	return charts, errors.Trace(err)
+}
+
+// Unmets returns the dependencies of the chart that none of the available versions, by chart name,
+// satisfy. Constraints that can't be parsed fail.
+func Unmets(s *semver.Semver, chart *Chart, available map[string][]string) ([]Unmet, error) {
+	var unmets []Unmet
+	for _, dependency := range chart.Dependencies {
+		// Helm separates comparators with commas as well, which the constraint grammar allows.
+		constraint, err := s.ParseConstraint(strings.TrimSpace(dependency.Version))
+		if err != nil {
+			return nil, errors.Annotatef(err, "dependency `%s` of chart `%s`", dependency.Name, chart.Name)
+		}
+		var met bool
+		for _, version := range available[dependency.Name] {
+			ok, err := constraint.Check(version)
+			if err != nil {
+				return nil, errors.Annotatef(err, "chart `%s`", dependency.Name)
+			}
+			if ok {
+				met = true
+				break
+			}
+		}
+		if !met {
+			unmets = append(unmets, Unmet{Chart: chart.Name, Dependency: dependency})
+		}
+	}
+	return unmets, nil
+}
+
+// Lint checks the dependencies of all charts against the versions of the charts themselves, like for
+// the charts of a single repository, and returns the unmet ones ordered by chart and dependency name.
+func Lint(s *semver.Semver, charts []*Chart) ([]Unmet, error) {
+	available := map[string][]string{}
+	for _, chart := range charts {
+		available[chart.Name] = append(available[chart.Name], chart.Version)
+	}
+	var unmets []Unmet
+	for _, chart := range charts {
+		chartUnmets, err := Unmets(s, chart, available)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		unmets = append(unmets, chartUnmets...)
+	}
+	sort.SliceStable(unmets, func(i, j int) bool {
+		if unmets[i].Chart != unmets[j].Chart {
+			return unmets[i].Chart < unmets[j].Chart
+		}
+		return unmets[i].Dependency.Name < unmets[j].Dependency.Name
+	})
+	return unmets, nil
+}
+
+// SupportsKube checks if the chart's `kubeVersion` constraint allows the Kubernetes version, which may
+// have a `v` prefix like `v1.28.3`. Charts without a `kubeVersion` support every version.
+func (c *Chart) SupportsKube(s *semver.Semver, kubeVersion string) (bool, error) {
+	if strings.TrimSpace(c.KubeVersion) == "" {
+		return true, nil
+	}
+	constraint, err := s.ParseConstraint(c.KubeVersion)
+	if err != nil {
+		return false, errors.Annotatef(err, "kubeVersion of chart `%s`", c.Name)
+	}
+	ok, err := constraint.Check(strings.TrimPrefix(kubeVersion, "v"))
+	return ok, errors.Trace(err)
+}
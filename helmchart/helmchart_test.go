@@ -0,0 +1,140 @@
+package helmchart_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/helmchart"
+)
+
+func writeChart(t *testing.T, root string, name string, content string) {
+	t.Helper()
+	dir := filepath.Join(root, name)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, helmchart.FileName), []byte(content), 0o640); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLint(t *testing.T) {
+	s := semver.MustNew()
+	root := t.TempDir()
+	writeChart(t, root, "common", "apiVersion: v2\nname: common\nversion: 1.4.2\n")
+	writeChart(t, root, "redis", "apiVersion: v2\nname: redis\nversion: 17.3.0\n")
+	writeChart(t, root, "app", `apiVersion: v2
+name: app
+version: 0.3.0
+appVersion: "2.1"
+dependencies:
+  - name: common
+    version: ">= 1.2.0, < 2.0.0"
+    repository: file://../common
+  - name: redis
+    version: ^18.0.0
+    repository: https://charts.example.com
+  - name: postgres
+    version: ~12.1.0
+`)
+	charts, err := helmchart.LoadDir(s, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(charts) != 3 {
+		t.Fatalf("expected 3 charts, got %d", len(charts))
+	}
+	unmets, err := helmchart.Lint(s, charts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, unmet := range unmets {
+		names = append(names, unmet.Chart+" "+unmet.Dependency.Name)
+	}
+	expected := []string{"app postgres", "app redis"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected %v, got %v", expected, names)
+	}
+}
+
+func TestUnmets(t *testing.T) {
+	s := semver.MustNew()
+	chart, err := helmchart.Load(s, strings.NewReader(`name: app
+version: 1.0.0
+dependencies:
+  - name: redis
+    version: ^17.0.0 || ^18.0.0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unmets, err := helmchart.Unmets(s, chart, map[string][]string{"redis": {"16.0.0", "18.2.1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unmets) != 0 {
+		t.Fatalf("expected no unmet dependencies, got %v", unmets)
+	}
+	chart.Dependencies[0].Version = "1.y"
+	if _, err := helmchart.Unmets(s, chart, nil); err == nil {
+		t.Fatal("expected an error for an invalid constraint")
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		name    string
+		content string
+	}{
+		{"yaml", "name: [app\n"},
+		{"no name", "version: 1.0.0\n"},
+		{"version", "name: app\nversion: 1.0\n"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			if _, err := helmchart.Load(s, strings.NewReader(c.content)); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+	if _, err := helmchart.LoadFile(s, filepath.Join(t.TempDir(), helmchart.FileName)); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
+
+func TestSupportsKube(t *testing.T) {
+	s := semver.MustNew()
+	chart := &helmchart.Chart{Name: "app", KubeVersion: ">=1.22.0-0 <1.30.0-0"}
+	cases := []struct {
+		version  string
+		expected bool
+	}{
+		{"v1.28.3", true},
+		{"1.22.0-eks-4f4795d", true},
+		{"v1.30.1", false},
+		{"v1.21.9", false},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version, func(t2 *testing.T) {
+			ok, err := chart.SupportsKube(s, c.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if ok != c.expected {
+				t2.Fatalf("expected %t, got %t", c.expected, ok)
+			}
+		})
+	}
+	ok, err := (&helmchart.Chart{Name: "any"}).SupportsKube(s, "v1.0.0")
+	if err != nil || !ok {
+		t.Fatalf("expected charts without kubeVersion to support any version, got %t, %v", ok, err)
+	}
+}
@@ -0,0 +1,145 @@
+package semver
+
+import (
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Release is a version with the time it was released.
+type Release struct {
+	Version    *Version
+	ReleasedAt time.Time
+}
+
+// History is the timeline of the releases of a project, so it can tell what was current at a time.
+type History struct {
+	semver   *Semver
+	releases []Release
+}
+
+// Velocity summarizes the releases of a period. A release counts as major when it's the first of its
+// major, as minor when it's the first of its minor and as patch otherwise, so backports are patches.
+// Prereleases are only counted by Prereleases.
+type Velocity struct {
+	Releases    int
+	Majors      int
+	Minors      int
+	Patches     int
+	Prereleases int
+	// MeanInterval is the mean time between the releases, or 0 for fewer than two.
+	MeanInterval time.Duration
+}
+
+// NewHistory returns a new, empty instance of History.
+func NewHistory(semver *Semver) *History {
+	return &History{semver: semver}
+}
+
+// Add parses the version and adds it to the history as released at the time.
+func (h *History) Add(version string, releasedAt time.Time) error {
+	v, err := h.semver.Parse(version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	k := sort.Search(len(h.releases), func(i int) bool {
+		return h.releases[i].ReleasedAt.After(releasedAt)
+	})
+	h.releases = append(h.releases, Release{})
+	copy(h.releases[k+1:], h.releases[k:])
+	h.releases[k] = Release{Version: v, ReleasedAt: releasedAt}
+	return nil
+}
+
+// Releases returns a copy of all releases ordered by their release time.
+func (h *History) Releases() []Release {
+	return append([]Release{}, h.releases...)
+}
+
+// LatestAsOf returns the highest stable version released at or before the time, which is what was
+// current then. It fails with a NotFound error when nothing was released yet.
+func (h *History) LatestAsOf(at time.Time) (*Version, error) {
+	var latest *Version
+	for k := range h.releases {
+		if h.releases[k].ReleasedAt.After(at) {
+			break
+		}
+		v := h.releases[k].Version
+		if v.Prerelease == "" && (latest == nil || v.Compare(latest) > 0) {
+			latest = v
+		}
+	}
+	if latest == nil {
+		return nil, errors.NotFoundf("release as of %s", at.Format(time.RFC3339))
+	}
+	return latest, nil
+}
+
+// ReleasedBetween returns a copy of the releases from the time up to, but excluding, the time to, ordered by
+// their release time.
+func (h *History) ReleasedBetween(from time.Time, to time.Time) []Release {
+	start := sort.Search(len(h.releases), func(i int) bool {
+		return !h.releases[i].ReleasedAt.Before(from)
+	})
+	end := sort.Search(len(h.releases), func(i int) bool {
+		return !h.releases[i].ReleasedAt.Before(to)
+	})
+	if end < start {
+		end = start
+	}
+	return append([]Release{}, h.releases[start:end]...)
+}
+
+// Velocity summarizes the releases from the time up to, but excluding, the time to. Releases from before
+// the period are taken into account to tell the kind of a release.
+func (h *History) Velocity(from time.Time, to time.Time) Velocity {
+	type minor struct {
+		epoch int
+		major int
+		minor int
+	}
+	majors := map[minor]bool{}
+	minors := map[minor]bool{}
+	var velocity Velocity
+	var first, last time.Time
+	for k := range h.releases {
+		release := h.releases[k]
+		if !release.ReleasedAt.Before(to) {
+			break
+		}
+		v := release.Version
+		inPeriod := !release.ReleasedAt.Before(from)
+		if inPeriod {
+			if velocity.Releases == 0 {
+				first = release.ReleasedAt
+			}
+			last = release.ReleasedAt
+			velocity.Releases++
+		}
+		if v.Prerelease != "" {
+			if inPeriod {
+				velocity.Prereleases++
+			}
+			continue
+		}
+		majorKey := minor{epoch: v.Epoch, major: v.Major}
+		minorKey := minor{epoch: v.Epoch, major: v.Major, minor: v.Minor}
+		if inPeriod {
+			switch {
+			case !majors[majorKey]:
+				velocity.Majors++
+			case !minors[minorKey]:
+				velocity.Minors++
+			default:
+				velocity.Patches++
+			}
+		}
+		majors[majorKey] = true
+		minors[minorKey] = true
+	}
+	if velocity.Releases > 1 {
+		velocity.MeanInterval = last.Sub(first) / time.Duration(velocity.Releases-1)
+	}
+	return velocity
+}
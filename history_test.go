@@ -0,0 +1,116 @@
+package semver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func newTestHistory(t *testing.T) *semver.History {
+	t.Helper()
+	history := semver.NewHistory(semver.MustNew())
+	releases := []struct {
+		version string
+		day     int
+	}{
+		{"1.0.0", 1},
+		{"1.1.0", 11},
+		{"2.0.0-rc.1", 15},
+		{"2.0.0", 21},
+		{"1.1.1", 26},
+		{"2.1.0", 31},
+	}
+	// Added out of order to check that the history orders them.
+	for k := len(releases) - 1; k >= 0; k-- {
+		at := time.Date(2024, 1, releases[k].day, 0, 0, 0, 0, time.UTC)
+		if err := history.Add(releases[k].version, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return history
+}
+
+func TestHistoryLatestAsOf(t *testing.T) {
+	history := newTestHistory(t)
+	cases := []struct {
+		day      int
+		expected string
+	}{
+		{1, "1.0.0"},
+		{10, "1.0.0"},
+		{16, "1.1.0"},
+		{21, "2.0.0"},
+		{27, "2.0.0"},
+		{31, "2.1.0"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.expected, func(t2 *testing.T) {
+			v, err := history.LatestAsOf(time.Date(2024, 1, c.day, 12, 0, 0, 0, time.UTC))
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if v.Original() != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, v.Original())
+			}
+		})
+	}
+	if _, err := history.LatestAsOf(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)); !errors.IsNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+}
+
+func TestHistoryReleasedBetween(t *testing.T) {
+	history := newTestHistory(t)
+	releases := history.ReleasedBetween(time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 26, 0, 0, 0, 0, time.UTC))
+	var versions []string
+	for _, release := range releases {
+		versions = append(versions, release.Version.Original())
+	}
+	expected := []string{"1.1.0", "2.0.0-rc.1", "2.0.0"}
+	if len(versions) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, versions)
+	}
+	for k := range expected {
+		if versions[k] != expected[k] {
+			t.Fatalf("expected %v, got %v", expected, versions)
+		}
+	}
+	if len(history.Releases()) != 6 {
+		t.Fatalf("expected 6 releases, got %d", len(history.Releases()))
+	}
+	releases[0] = semver.Release{}
+	history.Releases()[0] = semver.Release{}
+	for _, release := range history.Releases() {
+		if release.Version == nil {
+			t.Fatal("expected changing the returned releases to leave the history as is")
+		}
+	}
+}
+
+func TestHistoryVelocity(t *testing.T) {
+	history := newTestHistory(t)
+	velocity := history.Velocity(time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	expected := semver.Velocity{
+		Releases:     5,
+		Majors:       1,
+		Minors:       2,
+		Patches:      1,
+		Prereleases:  1,
+		MeanInterval: 5 * 24 * time.Hour,
+	}
+	if velocity != expected {
+		t.Fatalf("expected %+v, got %+v", expected, velocity)
+	}
+}
+
+func TestHistoryAddInvalid(t *testing.T) {
+	history := semver.NewHistory(semver.MustNew())
+	if err := history.Add("1.0", time.Now()); err == nil {
+		t.Fatal("expected an error")
+	}
+}
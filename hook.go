@@ -0,0 +1,23 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// applyOption applies the option, turning a panic into an error.
+func applyOption(s *Semver, option Option) (err error) {
+	defer recoverHook("option", &err)
+	return option(s)
+}
+
+// recoverHook turns a panic of the named hook into a *HookPanicError in err. It has to be deferred.
+func recoverHook(hook string, err *error) {
+	if r := recover(); r != nil {
+		*err = errors.Trace(&HookPanicError{Hook: hook, Value: r})
+	}
+}
+
+// ignorePanic recovers from a panic of a hook that can't fail, like Metrics. It has to be deferred.
+func ignorePanic() {
+	_ = recover()
+}
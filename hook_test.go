@@ -0,0 +1,186 @@
+package semver_test
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v3"
+)
+
+type panickingMetrics struct{}
+
+func (panickingMetrics) Validated(bool)           { panic("validated") }
+func (panickingMetrics) Compared(bool)            { panic("compared") }
+func (panickingMetrics) CacheLookup(string, bool) { panic("cache lookup") }
+
+type panickingHandler struct {
+	slog.Handler
+}
+
+func (panickingHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (panickingHandler) Handle(context.Context, slog.Record) error { panic("handle") }
+
+func assertHookPanic(t *testing.T, err error, hook string) {
+	t.Helper()
+	panicErr, ok := errors.Cause(err).(*semver.HookPanicError)
+	if !ok {
+		t.Fatalf("expected a *HookPanicError, got %v", err)
+	}
+	if panicErr.Hook != hook {
+		t.Fatalf("expected hook `%s`, got `%s`", hook, panicErr.Hook)
+	}
+}
+
+func TestHookPanicOption(t *testing.T) {
+	_, err := semver.New(func(*semver.Semver) error {
+		panic("option")
+	})
+	assertHookPanic(t, err, "option")
+}
+
+func TestHookPanicMetricsAndLogger(t *testing.T) {
+	s := semver.MustNew(semver.WithMetrics(panickingMetrics{}), semver.WithLogger(slog.New(panickingHandler{})))
+	if !s.Valid("1.2.3") || s.Valid("1.2") {
+		t.Fatal("expected validation to work with panicking hooks")
+	}
+	if _, err := s.Parse("1.2"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	c, err := s.Compare("1.2.3", "1.2.4")
+	if err != nil || c != -1 {
+		t.Fatalf("expected -1, got %d, %v", c, err)
+	}
+	results, err := s.CompareAll(context.Background(), [][2]string{{"1.0.0", "2.0.0"}, {"2.0.0", "1.0.0"}})
+	if err != nil || results[0] != -1 || results[1] != 1 {
+		t.Fatalf("expected [-1 1], got %v, %v", results, err)
+	}
+	semver.NewPool(s).Intern("1.2.3")
+}
+
+func TestHookPanicYAMLValidate(t *testing.T) {
+	config := struct {
+		Constraint semver.ConstraintYAML `yaml:"constraint"`
+	}{Constraint: semver.ConstraintYAML{Validate: func(*semver.Constraint) error {
+		panic("validate")
+	}}}
+	err := yaml.Unmarshal([]byte("constraint: ^1.2.0\n"), &config)
+	assertHookPanic(t, err, "validate")
+}
+
+func TestHookPanicFilterStream(t *testing.T) {
+	s := semver.MustNew()
+	results, err := s.ValidateStream(strings.NewReader("1.0.0\n2.0.0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []semver.Result
+	for result := range semver.FilterStream(results, func(v *semver.Version) bool {
+		if v.Major == 1 {
+			panic("predicate")
+		}
+		return true
+	}) {
+		got = append(got, result)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(got))
+	}
+	if got[0].Valid {
+		t.Fatal("expected the result the predicate panicked on to be invalid")
+	}
+	assertHookPanic(t, got[0].Err, "predicate")
+	if !got[1].Valid || got[1].Input != "2.0.0" {
+		t.Fatalf("expected valid `2.0.0`, got %+v", got[1])
+	}
+}
+
+// TestNoPanic asserts the no-panic contract for hostile input to the entry points that take strings.
+func TestNoPanic(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch(), semver.WithQuadSegments())
+	inputs := []string{
+		"", ":", "1:", ":1.2.3", "1.2.3.", "...", "-", "+", "1.2.3-", "1.2.3+", "1.2.3-+", "\x00",
+		"\xff\xfe", "99999999999999999999.0.0", "1.2.3-99999999999999999999", "1:2:3", "1..2.3",
+		strings.Repeat("9", 10000), strings.Repeat("1.", 1000) + "1", "||", ">=", "^", "~", "*", ">= ||",
+	}
+	for _, a := range inputs {
+		for _, b := range inputs {
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Fatalf("panicked on `%q` `%q`: %v", a, b, r)
+					}
+				}()
+				_ = s.Valid(a)
+				_, _ = s.Parse(a)
+				_, _ = s.Compare(a, b)
+				_, _ = s.CompareCore(a, b)
+				_, _ = s.InRange(a, a, b)
+				_, _ = s.Equal(a, b)
+				_, _ = s.MatchesPrefix(a, b)
+				_, _ = s.SortableKey(a)
+				_, _ = s.ParseConstraint(a)
+				_, _ = semver.Sanitize(a, semver.SanitizeOptions{ConvertFullWidth: true})
+				v := &semver.Version{}
+				_ = v.UnmarshalBinary([]byte(a))
+			}()
+		}
+	}
+}
+
+func TestHookPanicFilterWhere(t *testing.T) {
+	collection := semver.NewCollection(semver.MustNew())
+	for _, version := range []string{"1.0.0", "2.0.0"} {
+		if err := collection.Add(version, semver.Metadata{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := collection.Filter(func(*semver.Entry) bool {
+		panic("filter")
+	})
+	assertHookPanic(t, err, "filter")
+	_, err = collection.Where(func(*semver.Version) bool {
+		panic("predicate")
+	})
+	assertHookPanic(t, err, "predicate")
+	filtered, err := collection.Where(func(v *semver.Version) bool {
+		return v.Major == 2
+	})
+	if err != nil || len(filtered.Versions()) != 1 {
+		t.Fatalf("expected 1 version, got %v, %v", filtered, err)
+	}
+}
+
+func TestHookPanicInspect(t *testing.T) {
+	c, err := semver.MustNew().ParseConstraint(">=1.0.0 <2.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = semver.Inspect(c, func(node semver.Node) bool {
+		if _, ok := node.(*semver.Comparator); ok {
+			panic("visitor")
+		}
+		return true
+	})
+	assertHookPanic(t, err, "visitor")
+}
+
+type panickingReleaseSource struct{}
+
+func (panickingReleaseSource) Releases(context.Context) ([]string, error) { panic("releases") }
+
+func TestHookPanicReleaseSource(t *testing.T) {
+	s := semver.MustNew()
+	constraint, err := s.ParseConstraint(">=1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = semver.NewSelector(s).WaitForRelease(context.Background(), panickingReleaseSource{}, constraint,
+		semver.PollOptions{Interval: time.Millisecond, Timeout: time.Minute})
+	assertHookPanic(t, err, "source")
+}
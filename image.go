@@ -0,0 +1,85 @@
+package semver
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var reImageTag = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}$`)
+
+// Image is a container image reference like `registry.example.com/app:1.4.2-alpine` whose tag is a version.
+type Image struct {
+	// Name is the reference without tag and digest, like `registry.example.com/app`.
+	Name string
+	Tag  string
+	// Digest is the part after the `@`, like `sha256:...`, if any.
+	Digest string
+	// Version is parsed from the tag. A `v` prefix is allowed, and since tags can't contain a `+` an `_`
+	// separates the build metadata, so `v1.4.2_build.5` is `1.4.2+build.5`.
+	Version *Version
+
+	prefix string
+}
+
+// ParseImage parses the image reference and the version in its tag.
+func (s *Semver) ParseImage(reference string) (*Image, error) {
+	image := &Image{}
+	name := reference
+	if i := strings.IndexByte(name, '@'); i >= 0 {
+		name, image.Digest = name[:i], name[i+1:]
+	}
+	i := strings.LastIndexByte(name, ':')
+	if i < 0 || i < strings.LastIndexByte(name, '/') {
+		return nil, errors.Errorf("image `%s` has no tag", reference)
+	}
+	image.Name, image.Tag = name[:i], name[i+1:]
+	if image.Name == "" {
+		return nil, errors.Errorf("image `%s` has no name", reference)
+	}
+	if !reImageTag.MatchString(image.Tag) {
+		return nil, errors.Errorf("image `%s` has an invalid tag", reference)
+	}
+	version := image.Tag
+	if strings.HasPrefix(version, "v") {
+		image.prefix = "v"
+		version = version[1:]
+	}
+	v, err := s.Parse(strings.Replace(version, "_", "+", 1))
+	if err != nil {
+		return nil, errors.Annotatef(err, "image `%s`", reference)
+	}
+	image.Version = v
+	return image, nil
+}
+
+// String returns the image reference.
+func (i *Image) String() string {
+	reference := i.Name + ":" + i.Tag
+	if i.Digest != "" {
+		reference += "@" + i.Digest
+	}
+	return reference
+}
+
+// WithVersion returns the image with its tag rewritten to the version, keeping a `v` prefix.
+// The digest is dropped, since it belongs to the old tag.
+func (i *Image) WithVersion(v *Version) *Image {
+	tag := i.prefix + strings.Replace(v.canonical(), "+", "_", 1)
+	version := *v
+	version.original = v.canonical()
+	return &Image{Name: i.Name, Tag: tag, Version: &version, prefix: i.prefix}
+}
+
+// Bump returns the image with its tag's version bumped. The prerelease is kept, since image tags commonly
+// use it for variants, so a patch bump of `app:1.4.2-alpine` is `app:1.4.3-alpine`. The build metadata
+// and digest are dropped.
+func (i *Image) Bump(bump Bump) (*Image, error) {
+	next, err := i.Version.increment(bump)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	next.Prerelease = i.Version.Prerelease
+	return i.WithVersion(next), nil
+}
@@ -0,0 +1,126 @@
+package semver_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestParseImage(t *testing.T) {
+	cases := []struct {
+		reference string
+		name      string
+		tag       string
+		digest    string
+		version   string
+	}{
+		{"app:1.4.2", "app", "1.4.2", "", "1.4.2"},
+		{"registry.example.com/app:1.4.2-alpine", "registry.example.com/app", "1.4.2-alpine", "", "1.4.2-alpine"},
+		{"localhost:5000/team/app:v2.0.0", "localhost:5000/team/app", "v2.0.0", "", "2.0.0"},
+		{"app:1.4.2_build.5@sha256:abc123", "app", "1.4.2_build.5", "sha256:abc123", "1.4.2+build.5"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run("parse-image-"+c.reference, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			image, err := semver.ParseImage(c.reference)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if image.Name != c.name || image.Tag != c.tag || image.Digest != c.digest {
+				t2.Fatalf("unexpected name `%s`, tag `%s` and digest `%s`", image.Name, image.Tag, image.Digest)
+			}
+			if image.Version.Original() != c.version {
+				t2.Fatalf("expected version `%s`, got `%s`", c.version, image.Version.Original())
+			}
+			if image.String() != c.reference {
+				t2.Fatalf("expected `%s` to round-trip, got `%s`", c.reference, image)
+			}
+		})
+	}
+}
+
+func TestImageBump(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		reference string
+		bump      semver.Bump
+		expected  string
+	}{
+		{"registry.example.com/app:1.4.2-alpine", semver.BumpPatch, "registry.example.com/app:1.4.3-alpine"},
+		{"app:v1.4.2", semver.BumpMinor, "app:v1.5.0"},
+		{"app:1.4.2_build.5@sha256:abc123", semver.BumpMajor, "app:2.0.0"},
+		{"app:1.4.2", semver.BumpNone, "app:1.4.2"},
+	}
+	for k := range cases {
+		c := cases[k]
+		image, err := s.ParseImage(c.reference)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bumped, err := image.Bump(c.bump)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bumped.String() != c.expected {
+			t.Fatalf("expected a %s bump of `%s` to be `%s`, got `%s`", c.bump, c.reference, c.expected, bumped)
+		}
+		if bumped.Version.Compare(image.Version) < 0 {
+			t.Fatalf("expected `%s` not to be lower than `%s`", bumped, image)
+		}
+	}
+}
+
+func TestImageWithVersion(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	image, err := s.ParseImage("app:v1.0.0@sha256:abc123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := s.Parse("1.1.0-rc.1+sha.5114f85")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rewritten := image.WithVersion(v)
+	if rewritten.String() != "app:v1.1.0-rc.1_sha.5114f85" {
+		t.Fatalf("unexpected image `%s`", rewritten)
+	}
+	reparsed, err := s.ParseImage(rewritten.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reparsed.Version.Compare(v) != 0 || reparsed.Version.Build != v.Build {
+		t.Fatalf("expected the rewritten tag to parse back to `%s`, got `%s`", v.Original(), reparsed.Version.Original())
+	}
+}
+
+func TestParseImageErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, reference := range []string{"app", "localhost:5000/app", ":1.0.0", "app:latest", "app:1.0", "app:-1.0.0",
+		"app:1.0.0+build", "app:" + invalidVersions[0]} {
+		if _, err := s.ParseImage(reference); err == nil {
+			t.Fatalf("expected an error for `%s`", reference)
+		}
+	}
+	maxInt := strconv.Itoa(int(^uint(0) >> 1))
+	image, err := s.ParseImage("app:" + maxInt + ".0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := image.Bump(semver.BumpMajor); err == nil {
+		t.Fatal("expected an error for overflowing the major")
+	}
+}
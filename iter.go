@@ -0,0 +1,41 @@
+package semver
+
+import (
+	"iter"
+	"sort"
+)
+
+// All iterates over the versions that are valid, yielding their index in versions and the parsed
+// version. Invalid versions are skipped; validate them first when they should fail instead.
+func (s *Semver) All(versions []string) iter.Seq2[int, *Version] {
+	return func(yield func(int, *Version) bool) {
+		for k := range versions {
+			v, err := s.Parse(versions[k])
+			if err != nil {
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted iterates over the valid versions from lowest to highest precedence. Versions with an equal
+// precedence keep their order. Invalid versions are skipped.
+func (s *Semver) Sorted(versions []string) iter.Seq[*Version] {
+	return func(yield func(*Version) bool) {
+		parsed := make([]*Version, 0, len(versions))
+		for _, v := range s.All(versions) {
+			parsed = append(parsed, v)
+		}
+		sort.SliceStable(parsed, func(i, j int) bool {
+			return parsed[i].Compare(parsed[j]) < 0
+		})
+		for _, v := range parsed {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
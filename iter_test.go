@@ -0,0 +1,58 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestAll(t *testing.T) {
+	s := semver.MustNew()
+	versions := []string{"1.2.0", "1.2", "2.0.0-rc.1", "0.9.0"}
+	var indexes []int
+	var parsed []string
+	for k, v := range s.All(versions) {
+		indexes = append(indexes, k)
+		parsed = append(parsed, v.String())
+	}
+	if len(indexes) != 3 || indexes[0] != 0 || indexes[1] != 2 || indexes[2] != 3 {
+		t.Fatalf("expected the indexes of the valid versions, got %v", indexes)
+	}
+	if parsed[1] != "2.0.0-rc.1" {
+		t.Fatalf("expected `2.0.0-rc.1`, got `%s`", parsed[1])
+	}
+	for k := range s.All(versions) {
+		if k != 0 {
+			t.Fatal("expected the iteration to stop after a break")
+		}
+		break
+	}
+}
+
+func TestSorted(t *testing.T) {
+	s := semver.MustNew()
+	versions := []string{"1.2.0+b", "2.0.0", "1.2", "1.2.0+a", "2.0.0-rc.1", "0.9.0"}
+	expected := []string{"0.9.0", "1.2.0+b", "1.2.0+a", "2.0.0-rc.1", "2.0.0"}
+	var sorted []string
+	for v := range s.Sorted(versions) {
+		sorted = append(sorted, v.Original())
+	}
+	if len(sorted) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, sorted)
+	}
+	for k := range expected {
+		if sorted[k] != expected[k] {
+			t.Fatalf("expected %v, got %v", expected, sorted)
+		}
+	}
+	var count int
+	for range s.Sorted(versions) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Fatalf("expected the iteration to stop after a break, got %d", count)
+	}
+}
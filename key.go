@@ -0,0 +1,84 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// This stops compiling when Version is no longer comparable, which callers rely on for map keys.
+var _ map[Version]struct{}
+
+// Key is a comparable representation of a version's precedence, so versions can be used as map keys
+// without their build metadata or original notation splitting equal versions apart.
+type Key struct {
+	Epoch      int
+	Major      int
+	Minor      int
+	Patch      int
+	Revision   int
+	Prerelease string
+
+	sentinel int
+}
+
+// Key returns the comparable key of the version. Versions with an equal precedence have equal keys.
+func (v Version) Key() Key {
+	return Key{
+		Epoch:      v.Epoch,
+		Major:      v.Major,
+		Minor:      v.Minor,
+		Patch:      v.Patch,
+		Revision:   v.Revision,
+		Prerelease: v.Prerelease,
+		sentinel:   v.sentinel,
+	}
+}
+
+// sortableWidth is the number of digits of the biggest int, to which the numbers of a SortableKey are padded.
+const sortableWidth = 19
+
+// SortableKey validates the version and encodes its precedence as a string of which the byte order is
+// the semver order, so it can be used for range scans in key-value stores. The epoch, major, minor,
+// patch and revision are zero-padded to a fixed width and followed by `~` for releases, which sorts
+// after the `-` with the prerelease identifiers. Numeric identifiers are zero-padded behind a `0` and
+// alphanumeric identifiers are terminated by `!` behind a `1`. Build metadata isn't part of the key and
+// custom channel orders aren't either. Numeric identifiers longer than 19 digits aren't supported.
+func (s *Semver) SortableKey(version string) (string, error) {
+	v, err := s.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	var b strings.Builder
+	for k, n := range []int{v.Epoch, v.Major, v.Minor, v.Patch, v.Revision} {
+		if k > 0 {
+			b.WriteByte('.')
+		}
+		writePadded(&b, strconv.Itoa(n))
+	}
+	if v.Prerelease == "" {
+		b.WriteByte('~')
+		return b.String(), nil
+	}
+	b.WriteByte('-')
+	for _, identifier := range strings.Split(v.Prerelease, ".") {
+		if !isNumeric(identifier) {
+			b.WriteString("1" + identifier + "!")
+			continue
+		}
+		if len(identifier) > sortableWidth {
+			return "", errors.NotSupportedf("prerelease identifier `%s` of more than %d digits in a sortable key",
+				identifier, sortableWidth)
+		}
+		b.WriteByte('0')
+		writePadded(&b, identifier)
+	}
+	b.WriteByte('!')
+	return b.String(), nil
+}
+
+func writePadded(b *strings.Builder, digits string) {
+	b.WriteString(strings.Repeat("0", sortableWidth-len(digits)))
+	b.WriteString(digits)
+}
@@ -0,0 +1,100 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestKey(t *testing.T) {
+	s := semver.MustNew()
+	channels := semver.MustNew(semver.WithChannelOrder("alpha", "beta"))
+	zero := semver.Zero()
+	seen := map[semver.Key]string{}
+	for _, version := range []string{"1.2.3", "1.2.3-beta", "2.0.0"} {
+		seen[s.MustParse(version).Key()] = version
+	}
+	cases := []struct {
+		version  *semver.Version
+		expected string
+	}{
+		{s.MustParse("1.2.3+build.5"), "1.2.3"},
+		{channels.MustParse("1.2.3-beta"), "1.2.3-beta"},
+		{&semver.Version{Major: 2}, "2.0.0"},
+		{s.MustParse("1.2.3-alpha"), ""},
+		{&zero, ""},
+	}
+	for k := range cases {
+		c := cases[k]
+		if result := seen[c.version.Key()]; result != c.expected {
+			t.Fatalf("expected `%s` to find `%s`, got `%s`", c.version, c.expected, result)
+		}
+	}
+	if zero.Key() != (semver.Version{}).Key() {
+		t.Fatal("expected Zero to have the key of the zero value")
+	}
+	versions := map[semver.Version]bool{*s.MustParse("1.2.3"): true}
+	if !versions[*s.MustParse("1.2.3")] {
+		t.Fatal("expected a version to be usable as map key")
+	}
+}
+
+func TestSortableKey(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch(), semver.WithQuadSegments())
+	// Ordered by precedence, like the example in the semver 2.0.0 spec.
+	versions := []string{
+		"0.0.0-0",
+		"0.0.0",
+		"1.0.0-2",
+		"1.0.0-10",
+		"1.0.0--",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-alpha-1",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.0.1",
+		"1.0.10",
+		"1.10.0",
+		"10.0.0",
+		"1:0.0.0",
+	}
+	var previous string
+	for k := range versions {
+		key, err := s.SortableKey(versions[k])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k > 0 && previous >= key {
+			t.Fatalf("expected `%s` to sort after `%s`, got `%s` and `%s`", versions[k], versions[k-1], key, previous)
+		}
+		previous = key
+	}
+	a, err := s.SortableKey("1.2.3+build.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := s.SortableKey("1.2.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Fatalf("expected build metadata to be ignored, got `%s` and `%s`", a, b)
+	}
+	if len(a) != 5*19+4+1 {
+		t.Fatalf("expected a fixed width of %d, got %d", 5*19+4+1, len(a))
+	}
+}
+
+func TestSortableKeyErrors(t *testing.T) {
+	s := semver.MustNew()
+	if _, err := s.SortableKey("1.2"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := s.SortableKey("1.2.3-12345678901234567890"); err == nil {
+		t.Fatal("expected an error for a numeric identifier that's too long")
+	}
+}
@@ -0,0 +1,32 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// WithMaxLength rejects versions longer than n bytes before they reach the regex, which bounds the cost
+// of validating hostile input like multi-MB version headers. By default the length isn't limited.
+func WithMaxLength(n int) Option {
+	return func(s *Semver) error {
+		if n <= 0 {
+			return errors.Errorf("max length %d should be positive", n)
+		}
+		s.maxLength = n
+		return nil
+	}
+}
+
+func (s *Semver) tooLong(version string) bool {
+	return s.maxLength > 0 && len(version) > s.maxLength
+}
+
+// lengthError reports the first of the versions that's too long. The version itself isn't included,
+// so the error stays small.
+func (s *Semver) lengthError(versions ...string) error {
+	for k := range versions {
+		if s.tooLong(versions[k]) {
+			return errors.Errorf("version of %d bytes is longer than the max length of %d", len(versions[k]), s.maxLength)
+		}
+	}
+	return nil
+}
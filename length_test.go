@@ -0,0 +1,61 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestWithMaxLength(t *testing.T) {
+	s, err := semver.New(semver.WithMaxLength(16))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		version  string
+		expected bool
+	}{
+		{"1.2.3", true},
+		{"1.2.3-rc.1+b.123", true},
+		{"1.2.3-rc.1+b.1234", false},
+		{"1.2.3-" + strings.Repeat("a", 1<<20), false},
+	}
+	for k := range cases {
+		c := cases[k]
+		if result := s.Valid(c.version); result != c.expected {
+			t.Fatalf("expected validity of a %d byte version to be %v, got %v", len(c.version), c.expected, result)
+		}
+	}
+
+	_, err = s.Parse("1.2.3-" + strings.Repeat("a", 1<<20))
+	if err == nil {
+		t.Fatal("expected an error for a version that's too long")
+	}
+	if len(err.Error()) > 100 {
+		t.Fatalf("expected the error to leave out the version, got %d bytes", len(err.Error()))
+	}
+	if _, err := s.Compare("1.2.3", "1.2.3-"+strings.Repeat("a", 20)); err == nil {
+		t.Fatal("expected an error when comparing to a version that's too long")
+	}
+}
+
+func TestWithMaxLengthErrors(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if _, err := semver.New(semver.WithMaxLength(n)); err == nil {
+			t.Fatalf("expected an error for max length %d", n)
+		}
+	}
+}
+
+func TestValidRejectsNonDigitStart(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, version := range []string{"", "v1.2.3", " 1.2.3", "-1.2.3"} {
+		if semver.Valid(version) {
+			t.Fatalf("expected `%s` to be invalid", version)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Package lockfile reads and writes a simple lockfile that pins names to exact versions, along with the
+// constraint each version was resolved from.
+//
+// Every line holds a name, its version and its constraint, separated by whitespace:
+//
+//	# Comments and empty lines are ignored.
+//	api 1.4.2 ^1.2.0
+//	worker 2.0.0-rc.1 >=2.0.0-rc.1 <3.0.0
+//
+// The constraint is the rest of the line, so it may contain whitespace itself.
+package lockfile
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+// Entry is a name pinned to an exact version that satisfies its constraint.
+type Entry struct {
+	Name       string
+	Version    *semver.Version
+	Constraint *semver.Constraint
+}
+
+// Lockfile holds the pinned entries by name.
+type Lockfile struct {
+	semver  *semver.Semver
+	entries map[string]*Entry
+}
+
+// New returns an empty lockfile that validates versions and constraints with s.
+func New(s *semver.Semver) *Lockfile {
+	return &Lockfile{semver: s, entries: map[string]*Entry{}}
+}
+
+// Read reads a lockfile and validates every entry with s.
+func Read(s *semver.Semver, r io.Reader) (*Lockfile, error) {
+	l := New(s)
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) < 3 {
+			return nil, errors.Errorf("line %d should hold a name, version and constraint", line)
+		}
+		if _, ok := l.entries[fields[0]]; ok {
+			return nil, errors.Errorf("line %d pins `%s` again", line, fields[0])
+		}
+		if err := l.Pin(fields[0], fields[1], strings.Join(fields[2:], " ")); err != nil {
+			return nil, errors.Annotatef(err, "line %d", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return l, nil
+}
+
+// Pin pins the name to the version, replacing an existing entry. The version should satisfy the constraint.
+func (l *Lockfile) Pin(name string, version string, constraint string) error {
+	if name == "" || strings.ContainsAny(name, " \t\r\n#") {
+		return errors.Errorf("name `%s` is invalid", name)
+	}
+	v, err := l.semver.Parse(version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c, err := l.semver.ParseConstraint(constraint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !c.CheckVersion(v) {
+		return errors.Errorf("version `%s` of `%s` doesn't satisfy `%s`", version, name, constraint)
+	}
+	l.entries[name] = &Entry{Name: name, Version: v, Constraint: c}
+	return nil
+}
+
+// Get returns the entry pinned for the name.
+func (l *Lockfile) Get(name string) (*Entry, error) {
+	entry, ok := l.entries[name]
+	if !ok {
+		return nil, errors.NotFoundf("entry `%s`", name)
+	}
+	return entry, nil
+}
+
+// Remove removes the entry pinned for the name, if any.
+func (l *Lockfile) Remove(name string) {
+	delete(l.entries, name)
+}
+
+// Entries returns the entries sorted by name.
+func (l *Lockfile) Entries() []*Entry {
+	entries := make([]*Entry, 0, len(l.entries))
+	for _, entry := range l.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+// Write writes the entries sorted by name, so the output is stable. Versions are written with their
+// build metadata and constraints in their normalized form.
+func (l *Lockfile) Write(w io.Writer) error {
+	for _, entry := range l.Entries() {
+		version := entry.Version.StringWithOptions(semver.StringOptions{IncludeBuild: true})
+		if _, err := io.WriteString(w, entry.Name+" "+version+" "+entry.Constraint.String()+"\n"); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
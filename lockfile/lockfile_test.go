@@ -0,0 +1,98 @@
+package lockfile_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/lockfile"
+	"github.com/juju/errors"
+)
+
+func TestRead(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := "# pinned versions\n\nworker 2.0.0-rc.1 >=2.0.0-rc.1   <3.0.0\napi 1.4.2+b.7 ^1.2.0\n"
+	l, err := lockfile.Read(s, strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := l.Get("api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Version.Major != 1 || entry.Version.Minor != 4 || entry.Version.Patch != 2 {
+		t.Fatalf("expected `api` to be pinned to 1.4.2, got %s", entry.Version)
+	}
+	var buf bytes.Buffer
+	if err := l.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := "api 1.4.2+b.7 ^1.2.0\nworker 2.0.0-rc.1 >=2.0.0-rc.1 <3.0.0\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+	again, err := lockfile.Read(s, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Entries()) != 2 {
+		t.Fatalf("expected 2 entries after a round-trip, got %d", len(again.Entries()))
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{"missing constraint", "api 1.4.2\n"},
+		{"invalid version", "api 1.4 ^1.2.0\n"},
+		{"invalid constraint", "api 1.4.2 ^1.a\n"},
+		{"unsatisfied constraint", "api 2.0.0 ^1.2.0\n"},
+		{"duplicate", "api 1.4.2 ^1.2.0\napi 1.4.3 ^1.2.0\n"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			if _, err := lockfile.Read(s, strings.NewReader(c.input)); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestPin(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := lockfile.New(s)
+	if err := l.Pin("api", "1.4.2", "^1.2.0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Pin("api", "1.5.0", "^1.2.0"); err != nil {
+		t.Fatal(err)
+	}
+	entry, err := l.Get("api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Version.String() != "1.5.0" {
+		t.Fatalf("expected `api` to be repinned to 1.5.0, got %s", entry.Version)
+	}
+	if err := l.Pin("my api", "1.5.0", "^1.2.0"); err == nil {
+		t.Fatal("expected an error for a name with whitespace")
+	}
+	l.Remove("api")
+	if _, err := l.Get("api"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error after removing, got %v", err)
+	}
+}
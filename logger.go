@@ -0,0 +1,63 @@
+package semver
+
+import (
+	"log/slog"
+
+	"github.com/juju/errors"
+)
+
+// maxLoggedInput is the number of bytes of an input that's logged, so hostile input doesn't flood the logs.
+const maxLoggedInput = 128
+
+// WithLogger logs a debug record for every failed validation, parse and comparison, with the operation,
+// the input and the reason it failed. It helps to find out where malformed versions come from. Panics of
+// the logger's handler are recovered from and ignored.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Semver) error {
+		if logger == nil {
+			return errors.New("logger should not be nil")
+		}
+		s.logger = logger
+		return nil
+	}
+}
+
+func (s *Semver) logFailure(op string, input string, reason interface{}) {
+	if s.logger == nil {
+		return
+	}
+	defer ignorePanic()
+	attrs := []interface{}{"op", op, "input", input, "reason", reason}
+	if len(input) > maxLoggedInput {
+		attrs[3] = input[:maxLoggedInput] + "…"
+		attrs = append(attrs, "length", len(input))
+	}
+	if err, ok := reason.(error); ok {
+		attrs[5] = err.Error()
+	}
+	s.logger.Debug("semver: "+op+" failed", attrs...)
+}
+
+// invalidReason describes why the version isn't valid.
+func (s *Semver) invalidReason(version string) string {
+	if s.tooLong(version) {
+		return s.lengthError(version).Error()
+	}
+	if !s.matchesPattern(version) {
+		return "doesn't match the pattern"
+	}
+	version, err := s.input(version)
+	if err != nil {
+		return "doesn't match the grammar"
+	}
+	if err := s.checkLeadingZeros(version); err != nil {
+		return err.Error()
+	}
+	if s.validSyntax(version) {
+		if _, err := s.buildVersion(version); err != nil {
+			return errors.Cause(err).Error()
+		}
+		return "a number doesn't fit in an int"
+	}
+	return "invalid syntax"
+}
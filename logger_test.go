@@ -0,0 +1,72 @@
+package semver_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestWithLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	s, err := semver.New(semver.WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		op     string
+		run    func()
+		input  string
+		reason string
+	}{
+		{"valid", func() { s.Valid("1.2") }, "1.2", "invalid syntax"},
+		{"valid", func() { s.Valid("01.2.3") }, "01.2.3", "has a leading zero"},
+		{"parse", func() { _, _ = s.Parse("1.2.x") }, "1.2.x", "is invalid"},
+		{"compare", func() { _, _ = s.Compare("1.2.3", "2.0") }, "1.2.3 2.0", "compare `2.0` is invalid"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.op+" "+c.input, func(t2 *testing.T) {
+			buf.Reset()
+			c.run()
+			var record map[string]interface{}
+			if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+				t2.Fatalf("expected a single record, got %q", buf.String())
+			}
+			if record["level"] != "DEBUG" || record["op"] != c.op || record["input"] != c.input {
+				t2.Fatalf("unexpected record %v", record)
+			}
+			if reason, _ := record["reason"].(string); !strings.Contains(reason, c.reason) {
+				t2.Fatalf("expected the reason to contain `%s`, got `%s`", c.reason, reason)
+			}
+		})
+	}
+
+	buf.Reset()
+	if !s.Valid("1.2.3") {
+		t.Fatal("expected `1.2.3` to be valid")
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected no records for a valid version, got %q", buf.String())
+	}
+
+	buf.Reset()
+	s.Valid(strings.Repeat("9", 1000))
+	var record map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+	if input, _ := record["input"].(string); len(input) > 200 || record["length"] != float64(1000) {
+		t.Fatalf("expected a truncated input with its length, got %v", record)
+	}
+}
+
+func TestWithLoggerNil(t *testing.T) {
+	if _, err := semver.New(semver.WithLogger(nil)); err == nil {
+		t.Fatal("expected an error for a nil logger")
+	}
+}
@@ -0,0 +1,83 @@
+package manifest
+
+import (
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+// cargoKinds are the tables of a Cargo.toml with dependencies, in the order they're returned.
+var cargoKinds = []string{"dependencies", "dev-dependencies", "build-dependencies"}
+
+var cargoOperators = []string{">=", "<=", ">", "<", "=", "^", "~"}
+
+// ReadCargoTOML reads the dependencies of a Cargo.toml, including the platform specific ones of
+// `[target.*]` tables, ordered by table and name. Requirements are normalized by the Cargo rules, where
+// a bare version like `1.2` is a caret requirement. Path, Git and workspace dependencies without
+// a version have no constraint.
+func ReadCargoTOML(s *semver.Semver, content string) ([]Dependency, error) {
+	var manifest struct {
+		Dependencies      map[string]interface{}            `toml:"dependencies"`
+		DevDependencies   map[string]interface{}            `toml:"dev-dependencies"`
+		BuildDependencies map[string]interface{}            `toml:"build-dependencies"`
+		Target            map[string]map[string]interface{} `toml:"target"`
+	}
+	if _, err := toml.Decode(content, &manifest); err != nil {
+		return nil, errors.Trace(err)
+	}
+	sections := map[string][]map[string]interface{}{
+		"dependencies":       {manifest.Dependencies},
+		"dev-dependencies":   {manifest.DevDependencies},
+		"build-dependencies": {manifest.BuildDependencies},
+	}
+	for _, target := range manifest.Target {
+		for _, kind := range cargoKinds {
+			if section, ok := target[kind].(map[string]interface{}); ok {
+				sections[kind] = append(sections[kind], section)
+			}
+		}
+	}
+
+	var dependencies []Dependency
+	for _, kind := range cargoKinds {
+		for _, section := range sections[kind] {
+			for name, value := range section {
+				dependency := Dependency{Name: name, Kind: kind}
+				switch value := value.(type) {
+				case string:
+					dependency.Raw = value
+				case map[string]interface{}:
+					dependency.Raw, _ = value["version"].(string)
+				default:
+					return nil, errors.Errorf("dependency `%s` should be a string or a table", name)
+				}
+				if dependency.Raw != "" {
+					expression, ok := cargoExpression(dependency.Raw)
+					dependency.Constraint = constraint(s, expression, ok)
+				}
+				dependencies = append(dependencies, dependency)
+			}
+		}
+	}
+	sortDependencies(dependencies, cargoKinds)
+	return dependencies, nil
+}
+
+// cargoExpression adds the caret Cargo implies to requirements without an operator, like `1.2`. The
+// partial versions are parsed by the semver package, which expands them by the same rules as Cargo.
+func cargoExpression(spec string) (string, bool) {
+	var expressions []string
+	for _, requirement := range strings.Split(spec, ",") {
+		operator, version := splitOperator(strings.TrimSpace(requirement), cargoOperators)
+		if version == "" {
+			return "", false
+		}
+		if operator == "" && version != "*" && !strings.ContainsAny(strings.SplitN(version, "-", 2)[0], "*xX") {
+			operator = "^"
+		}
+		expressions = append(expressions, operator+version)
+	}
+	return strings.Join(expressions, " "), true
+}
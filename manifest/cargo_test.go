@@ -0,0 +1,49 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/manifest"
+)
+
+func TestReadCargoTOML(t *testing.T) {
+	s := semver.MustNew()
+	dependencies, err := manifest.ReadCargoTOML(s, `[package]
+name = "app"
+version = "0.1.0"
+
+[dependencies]
+serde = "1.0"
+tokio = { version = "1.35", features = ["full"] }
+log = "=0.4.20"
+regex = ">=1.5, <1.10"
+rand = "0.8.*"
+local = { path = "../local" }
+zeros = "01.2"
+
+[dev-dependencies]
+criterion = "~0.5"
+
+[target.'cfg(windows)'.dependencies]
+winapi = "0.3.9"
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDependencies(t, dependencies, []expectation{
+		{"dependencies", "local", ""},
+		{"dependencies", "log", "0.4.20"},
+		{"dependencies", "rand", ">=0.8.0 <0.9.0-0"},
+		{"dependencies", "regex", ">=1.5.0 <1.10.0-0"},
+		{"dependencies", "serde", "^1.0.0"},
+		{"dependencies", "tokio", "^1.35.0"},
+		{"dependencies", "winapi", "^0.3.9"},
+		{"dependencies", "zeros", ""},
+		{"dev-dependencies", "criterion", "~0.5.0"},
+	})
+
+	if _, err := manifest.ReadCargoTOML(s, "[dependencies]\nserde = 1\n"); err == nil {
+		t.Fatal("expected an error for a dependency that isn't a string or a table")
+	}
+}
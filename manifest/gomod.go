@@ -0,0 +1,63 @@
+package manifest
+
+import (
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+// The kinds of go.mod dependencies.
+const (
+	KindRequire  = "require"
+	KindIndirect = "indirect"
+)
+
+// ReadGoMod reads the requirements of a go.mod, in the order they're written. Go uses minimal version
+// selection, so a requirement on `v1.2.3` is normalized to `>=1.2.3`. Requirements marked with
+// `// indirect` have KindIndirect as kind.
+func ReadGoMod(s *semver.Semver, content string) ([]Dependency, error) {
+	var dependencies []Dependency
+	var inBlock bool
+	for k, line := range strings.Split(content, "\n") {
+		var comment string
+		if i := strings.Index(line, "//"); i >= 0 {
+			line, comment = line[:i], line[i+2:]
+		}
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case inBlock && fields[0] == ")":
+			inBlock = false
+			continue
+		case !inBlock && fields[0] == "require":
+			if len(fields) == 2 && fields[1] == "(" {
+				inBlock = true
+				continue
+			}
+			fields = fields[1:]
+		case !inBlock:
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, errors.Errorf("line %d: requirement `%s` should have a module path and a version", k+1,
+				strings.TrimSpace(line))
+		}
+		kind := KindRequire
+		if strings.TrimSpace(comment) == "indirect" || strings.HasPrefix(strings.TrimSpace(comment), "indirect;") {
+			kind = KindIndirect
+		}
+		version := strings.Trim(fields[1], `"`)
+		dependencies = append(dependencies, Dependency{
+			Name:       strings.Trim(fields[0], `"`),
+			Kind:       kind,
+			Raw:        version,
+			Constraint: constraint(s, ">="+strings.TrimPrefix(version, "v"), strings.HasPrefix(version, "v")),
+		})
+	}
+	if inBlock {
+		return nil, errors.New("require block is not closed")
+	}
+	return dependencies, nil
+}
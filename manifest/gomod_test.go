@@ -0,0 +1,52 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/manifest"
+)
+
+func TestReadGoMod(t *testing.T) {
+	s := semver.MustNew()
+	dependencies, err := manifest.ReadGoMod(s, `module example.com/app
+
+go 1.23
+
+require github.com/juju/errors v1.0.0
+
+require (
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1
+	example.com/legacy v2.0.0+incompatible
+	example.com/pseudo v0.0.0-20240101120000-abcdef123456
+)
+
+replace example.com/legacy => ../legacy
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDependencies(t, dependencies, []expectation{
+		{manifest.KindRequire, "github.com/juju/errors", ">=1.0.0"},
+		{manifest.KindIndirect, "golang.org/x/text", ">=0.14.0"},
+		{manifest.KindRequire, "gopkg.in/yaml.v3", ">=3.0.1"},
+		{manifest.KindRequire, "example.com/legacy", ">=2.0.0+incompatible"},
+		{manifest.KindRequire, "example.com/pseudo", ">=0.0.0-20240101120000-abcdef123456"},
+	})
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"MissingVersion", "require example.com/app\n"},
+		{"UnclosedBlock", "require (\n\texample.com/app v1.0.0\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t2 *testing.T) {
+			if _, err := manifest.ReadGoMod(s, test.content); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package manifest reads the dependencies from the manifests of popular ecosystems, like package.json,
+// go.mod, requirements.txt and Cargo.toml, and normalizes their version requirements into constraints,
+// so the dependencies of different ecosystems can be audited the same way. It only reads the given
+// content and never touches the network.
+package manifest
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+)
+
+// Dependency is a dependency read from a manifest.
+type Dependency struct {
+	Name string
+	// Kind is the section of the manifest the dependency was read from, like `devDependencies` or
+	// `dev-dependencies`. It's empty for formats without sections.
+	Kind string
+	// Raw is the requirement as written in the manifest.
+	Raw string
+	// Constraint is nil when the requirement isn't a version range, like a Git URL, a path or a tag.
+	Constraint *semver.Constraint
+}
+
+// constraint parses the normalized expression with the partial versions of the semver package, which
+// is empty when the requirement couldn't be normalized.
+func constraint(s *semver.Semver, expression string, ok bool) *semver.Constraint {
+	if !ok {
+		return nil
+	}
+	c, err := s.ParseConstraint(expression)
+	if err != nil {
+		return nil
+	}
+	return c
+}
+
+// sortDependencies orders the dependencies by the kinds in the given order and then by name.
+func sortDependencies(dependencies []Dependency, kinds []string) {
+	rank := map[string]int{}
+	for k := range kinds {
+		rank[kinds[k]] = k
+	}
+	sort.SliceStable(dependencies, func(i, j int) bool {
+		if dependencies[i].Kind != dependencies[j].Kind {
+			return rank[dependencies[i].Kind] < rank[dependencies[j].Kind]
+		}
+		return dependencies[i].Name < dependencies[j].Name
+	})
+}
+
+// splitOperator splits the leading operator of the comparator off its version.
+func splitOperator(comparator string, operators []string) (string, string) {
+	for _, operator := range operators {
+		if strings.HasPrefix(comparator, operator) {
+			return operator, strings.TrimSpace(comparator[len(operator):])
+		}
+	}
+	return "", comparator
+}
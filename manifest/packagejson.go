@@ -0,0 +1,80 @@
+package manifest
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+// packageJSONKinds are the sections of a package.json with dependencies, in the order they're returned.
+var packageJSONKinds = []string{"dependencies", "devDependencies", "peerDependencies", "optionalDependencies"}
+
+// ReadPackageJSON reads the dependencies of a package.json, ordered by section and name. Ranges are
+// normalized by the npm rules, including partial versions like `1.2.x`, hyphen ranges like
+// `1.2.3 - 2.3` and `||`. URLs, paths, aliases and tags like `latest` have no constraint.
+func ReadPackageJSON(s *semver.Semver, content string) ([]Dependency, error) {
+	var manifest map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(content), &manifest); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var dependencies []Dependency
+	for _, kind := range packageJSONKinds {
+		raw, ok := manifest[kind]
+		if !ok {
+			continue
+		}
+		var section map[string]string
+		if err := json.Unmarshal(raw, &section); err != nil {
+			return nil, errors.Annotatef(err, "section `%s`", kind)
+		}
+		for name, spec := range section {
+			expression, ok := npmExpression(spec)
+			dependencies = append(dependencies, Dependency{
+				Name:       name,
+				Kind:       kind,
+				Raw:        spec,
+				Constraint: constraint(s, expression, ok),
+			})
+		}
+	}
+	sortDependencies(dependencies, packageJSONKinds)
+	return dependencies, nil
+}
+
+func npmExpression(spec string) (string, bool) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return "*", true
+	}
+	if strings.ContainsAny(spec, ":/") {
+		return "", false
+	}
+	var groups []string
+	for _, group := range strings.Split(spec, "||") {
+		expression, ok := npmGroup(strings.Fields(group))
+		if !ok {
+			return "", false
+		}
+		groups = append(groups, expression)
+	}
+	return strings.Join(groups, " || "), true
+}
+
+// npmGroup rewrites a hyphen range like `1.2.3 - 2.3` to `>=1.2.3 <=2.3`, which is what it means in npm.
+// The other comparators are parsed by the semver package, which expands partial versions by the npm rules.
+func npmGroup(tokens []string) (string, bool) {
+	if len(tokens) == 0 {
+		return "*", true
+	}
+	if len(tokens) == 3 && tokens[1] == "-" {
+		return ">=" + tokens[0] + " <=" + tokens[2], true
+	}
+	for k := range tokens {
+		if tokens[k] == "-" {
+			return "", false
+		}
+	}
+	return strings.Join(tokens, " "), true
+}
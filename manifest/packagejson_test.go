@@ -0,0 +1,83 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/manifest"
+)
+
+// expectation is the expected kind, name and normalized constraint of a dependency, with an empty
+// constraint for none.
+type expectation struct {
+	kind       string
+	name       string
+	constraint string
+}
+
+func checkDependencies(t *testing.T, dependencies []manifest.Dependency, expected []expectation) {
+	t.Helper()
+	if len(dependencies) != len(expected) {
+		t.Fatalf("expected %d dependencies, got %v", len(expected), dependencies)
+	}
+	for k := range expected {
+		var constraint string
+		if dependencies[k].Constraint != nil {
+			constraint = dependencies[k].Constraint.String()
+		}
+		if dependencies[k].Kind != expected[k].kind || dependencies[k].Name != expected[k].name ||
+			constraint != expected[k].constraint {
+			t.Fatalf("dependency %d: expected %v, got `%s` `%s` `%s` from `%s`", k, expected[k], dependencies[k].Kind,
+				dependencies[k].Name, constraint, dependencies[k].Raw)
+		}
+	}
+}
+
+func TestReadPackageJSON(t *testing.T) {
+	s := semver.MustNew()
+	dependencies, err := manifest.ReadPackageJSON(s, `{
+		"name": "app",
+		"dependencies": {
+			"react": "^18.2.0",
+			"lodash": "~4.17",
+			"express": "4.x",
+			"left-pad": "1.3.0",
+			"debug": ">= 2.6.9 < 3 || ^4.0.0",
+			"chalk": "2.0 - 3",
+			"mine": "file:../mine",
+			"repo": "github:user/repo",
+			"next": "latest",
+			"any": "",
+			"zeros": "^01.2"
+		},
+		"devDependencies": {
+			"jest": "^0.0",
+			"typescript": ">5.1"
+		}
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDependencies(t, dependencies, []expectation{
+		{"dependencies", "any", "*"},
+		{"dependencies", "chalk", ">=2.0.0 <4.0.0-0"},
+		{"dependencies", "debug", ">=2.6.9 <3.0.0-0 || ^4.0.0"},
+		{"dependencies", "express", ">=4.0.0 <5.0.0-0"},
+		{"dependencies", "left-pad", "1.3.0"},
+		{"dependencies", "lodash", "~4.17.0"},
+		{"dependencies", "mine", ""},
+		{"dependencies", "next", ""},
+		{"dependencies", "react", "^18.2.0"},
+		{"dependencies", "repo", ""},
+		{"dependencies", "zeros", ""},
+		{"devDependencies", "jest", ">=0.0.0 <0.1.0-0"},
+		{"devDependencies", "typescript", ">=5.2.0"},
+	})
+
+	if _, err := manifest.ReadPackageJSON(s, `{"dependencies": ["react"]}`); err == nil {
+		t.Fatal("expected an error for a malformed section")
+	}
+	if _, err := manifest.ReadPackageJSON(s, `{`); err == nil {
+		t.Fatal("expected an error for malformed JSON")
+	}
+}
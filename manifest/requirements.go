@@ -0,0 +1,110 @@
+package manifest
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+var (
+	pep440Operators = []string{"===", "~=", "==", "!=", "<=", ">=", "<", ">"}
+	reRequirement   = regexp.MustCompile(`^([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)\s*(?:\[[^\]]*\])?\s*(.*)$`)
+	reRelease       = regexp.MustCompile(`^(?:0|[1-9]\d*)(?:\.(?:0|[1-9]\d*)){0,2}$`)
+)
+
+// ReadRequirements reads the requirements of a pip requirements.txt, in the order they're written.
+// Comments, options like `-r other.txt` and environment markers are skipped. Specifiers are normalized
+// by PEP 440, so `==2.0` is `=2.0.0`, `==2.*` is `>=2.0.0 <3.0.0-0` and `~=2.2` is `>=2.2.0 <3.0.0-0`.
+// Versions with PEP 440 parts semver doesn't have, like `2.0rc1` or `1!2.0`, and URLs have no constraint.
+func ReadRequirements(s *semver.Semver, content string) ([]Dependency, error) {
+	var dependencies []Dependency
+	lines := strings.Split(strings.ReplaceAll(content, "\\\n", ""), "\n")
+	for k, line := range lines {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if i := strings.Index(line, ";"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "-") {
+			continue
+		}
+		matches := reRequirement.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, errors.Errorf("line %d: requirement `%s` is invalid", k+1, line)
+		}
+		spec := strings.TrimSpace(matches[2])
+		var expression string
+		var ok bool
+		if strings.HasPrefix(spec, "@") {
+			spec = strings.TrimSpace(spec[1:])
+		} else {
+			expression, ok = pep440Expression(spec)
+		}
+		dependencies = append(dependencies, Dependency{
+			Name:       matches[1],
+			Raw:        spec,
+			Constraint: constraint(s, expression, ok),
+		})
+	}
+	return dependencies, nil
+}
+
+func pep440Expression(spec string) (string, bool) {
+	if spec == "" {
+		return "*", true
+	}
+	var expressions []string
+	for _, clause := range strings.Split(spec, ",") {
+		operator, version := splitOperator(strings.TrimSpace(clause), pep440Operators)
+		expression, ok := pep440Clause(operator, version)
+		if !ok {
+			return "", false
+		}
+		expressions = append(expressions, expression)
+	}
+	return strings.Join(expressions, " "), true
+}
+
+func pep440Clause(operator string, version string) (string, bool) {
+	if operator == "==" && strings.HasSuffix(version, ".*") && reRelease.MatchString(version[:len(version)-2]) {
+		// A wildcard like `2.*` is parsed by the semver package, which expands it the same way.
+		return version, true
+	}
+	if !reRelease.MatchString(version) {
+		return "", false
+	}
+	parts := strings.Split(version, ".")
+	switch operator {
+	case "==":
+		return "=" + pep440Release(parts), true
+	case "!=", "<=", ">=", "<", ">":
+		return operator + pep440Release(parts), true
+	case "~=":
+		// The compatible release of `2.2` is `>=2.2, ==2.*`, so the last part of the version may change.
+		if len(parts) < 2 {
+			return "", false
+		}
+		upper := append([]string{}, parts[:len(parts)-1]...)
+		n, err := strconv.Atoi(upper[len(upper)-1])
+		if err != nil {
+			return "", false
+		}
+		upper[len(upper)-1] = strconv.Itoa(n + 1)
+		return ">=" + pep440Release(parts) + " <" + pep440Release(upper) + "-0", true
+	}
+	return "", false
+}
+
+// pep440Release pads the parts of a release with zeros to a full version, since `2.0` is `2.0.0` in
+// PEP 440 and not a range like in npm.
+func pep440Release(parts []string) string {
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+	return strings.Join(parts, ".")
+}
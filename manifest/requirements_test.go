@@ -0,0 +1,47 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/manifest"
+)
+
+func TestReadRequirements(t *testing.T) {
+	s := semver.MustNew()
+	dependencies, err := manifest.ReadRequirements(s, `# production
+-r base.txt
+--index-url https://example.com/simple
+requests[security]>=2.28,<3
+Django==4.2.*
+numpy==1.26
+flask~=2.2
+urllib3 ~= 1.26.5
+idna!=3.0, >=2.5
+pytz
+black==23.1b0
+local @ file:///src/local
+pywin32>=300 ; sys_platform == "win32"
+zeros==01.2
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDependencies(t, dependencies, []expectation{
+		{"", "requests", ">=2.28.0 <3.0.0"},
+		{"", "Django", ">=4.2.0 <4.3.0-0"},
+		{"", "numpy", "1.26.0"},
+		{"", "flask", ">=2.2.0 <3.0.0-0"},
+		{"", "urllib3", ">=1.26.5 <1.27.0-0"},
+		{"", "idna", "!=3.0.0 >=2.5.0"},
+		{"", "pytz", "*"},
+		{"", "black", ""},
+		{"", "local", ""},
+		{"", "pywin32", ">=300.0.0"},
+		{"", "zeros", ""},
+	})
+
+	if _, err := manifest.ReadRequirements(s, "==1.0\n"); err == nil {
+		t.Fatal("expected an error for a requirement without a name")
+	}
+}
@@ -0,0 +1,124 @@
+package semver
+
+import (
+	"strings"
+)
+
+// mavenQualifiers orders the well-known qualifiers. The empty qualifier is a release.
+// Unknown qualifiers sort after all of them, alphabetically.
+var mavenQualifiers = map[string]int{
+	"alpha":     0,
+	"beta":      1,
+	"milestone": 2,
+	"rc":        3,
+	"snapshot":  4,
+	"":          5,
+	"sp":        6,
+}
+
+var mavenAliases = map[string]string{
+	"a":       "alpha",
+	"b":       "beta",
+	"m":       "milestone",
+	"cr":      "rc",
+	"ga":      "",
+	"final":   "",
+	"release": "",
+}
+
+type mavenItem struct {
+	numeric bool
+	value   string
+}
+
+func validMaven(version string) bool {
+	if version == "" || !isDigit(version[0]) {
+		return false
+	}
+	for i := 0; i < len(version); i++ {
+		c := version[i]
+		if !isDigit(c) && !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && c != '.' && c != '-' &&
+			c != '_' && c != '+' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseMaven splits a version into numeric and qualifier items on separators and on transitions
+// between digits and letters. Trailing items that equal a release, like `.0` or `-final`, are dropped.
+func parseMaven(version string) []mavenItem {
+	var items []mavenItem
+	version = strings.ToLower(version)
+	var start int
+	for i := 0; i <= len(version); i++ {
+		atEnd := i == len(version)
+		if !atEnd && !strings.ContainsRune(".-_+", rune(version[i])) &&
+			(i == start || isDigit(version[i]) == isDigit(version[start])) {
+			continue
+		}
+		if i > start {
+			item := mavenItem{numeric: isDigit(version[start]), value: version[start:i]}
+			if item.numeric {
+				item.value = strings.TrimLeft(item.value, "0")
+			} else if alias, ok := mavenAliases[item.value]; ok {
+				item.value = alias
+			}
+			items = append(items, item)
+		}
+		start = i
+		if !atEnd && strings.ContainsRune(".-_+", rune(version[i])) {
+			start = i + 1
+		}
+	}
+	for len(items) > 0 && items[len(items)-1].value == "" {
+		items = items[:len(items)-1]
+	}
+	return items
+}
+
+// compareMaven compares two valid Maven versions item by item, padding the shorter one with
+// release items. Numbers sort after qualifiers, so `1.0.1` is higher than `1.0-sp`.
+func compareMaven(a string, b string) int {
+	itemsA := parseMaven(a)
+	itemsB := parseMaven(b)
+	release := mavenItem{}
+	for k := 0; k < len(itemsA) || k < len(itemsB); k++ {
+		itemA, itemB := release, release
+		if k < len(itemsA) {
+			itemA = itemsA[k]
+		}
+		if k < len(itemsB) {
+			itemB = itemsB[k]
+		}
+		if c := compareMavenItems(itemA, itemB); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareMavenItems(a mavenItem, b mavenItem) int {
+	// An empty item is a release, which equals zero when compared to a number.
+	switch {
+	case a.numeric && (b.numeric || b.value == ""):
+		return compareNumericStrings(a.value, b.value)
+	case b.numeric && a.value == "":
+		return compareNumericStrings(a.value, b.value)
+	case a.numeric:
+		return 1
+	case b.numeric:
+		return -1
+	}
+	rankA, knownA := mavenQualifiers[a.value]
+	rankB, knownB := mavenQualifiers[b.value]
+	switch {
+	case knownA && knownB:
+		return compareInts(rankA, rankB)
+	case knownA:
+		return -1
+	case knownB:
+		return 1
+	}
+	return strings.Compare(a.value, b.value)
+}
@@ -0,0 +1,49 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// Metrics receives instrumentation events of a Semver, so version processing can be monitored. The
+// methods are called synchronously and concurrently, so implementations should be fast and safe for
+// concurrent use. Panics of the methods are recovered from and ignored.
+type Metrics interface {
+	// Validated is called for every version that's validated or parsed, with whether it was valid.
+	Validated(ok bool)
+	// Compared is called for every comparison of two version strings, with whether both were valid.
+	Compared(ok bool)
+	// CacheLookup is called for every lookup in a cache like a Pool, with whether it was a hit.
+	CacheLookup(cache string, hit bool)
+}
+
+// WithMetrics reports the validations, comparisons and cache lookups of the Semver to metrics.
+func WithMetrics(metrics Metrics) Option {
+	return func(s *Semver) error {
+		if metrics == nil {
+			return errors.New("metrics should not be nil")
+		}
+		s.metrics = metrics
+		return nil
+	}
+}
+
+func (s *Semver) validated(ok bool) {
+	if s.metrics != nil {
+		defer ignorePanic()
+		s.metrics.Validated(ok)
+	}
+}
+
+func (s *Semver) compared(ok bool) {
+	if s.metrics != nil {
+		defer ignorePanic()
+		s.metrics.Compared(ok)
+	}
+}
+
+func (s *Semver) cacheLookup(cache string, hit bool) {
+	if s.metrics != nil {
+		defer ignorePanic()
+		s.metrics.CacheLookup(cache, hit)
+	}
+}
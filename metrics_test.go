@@ -0,0 +1,13 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestWithMetricsNil(t *testing.T) {
+	if _, err := semver.New(semver.WithMetrics(nil)); err == nil {
+		t.Fatal("expected an error for nil metrics")
+	}
+}
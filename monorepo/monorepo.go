@@ -0,0 +1,221 @@
+// Package monorepo checks the versions of the packages of a monorepo against the requirements they have
+// on each other, like `api` 2.x requiring `core` >=1.4.0.
+//
+// A manifest lists the packages with their versions and the requirements, one per line:
+//
+//	# Comments and empty lines are ignored.
+//	api 2.3.0
+//	core 1.5.0
+//	api ^2.0.0 requires core >=1.4.0
+//
+// A requirement holds the package, the constraint its version has to satisfy for the requirement to
+// apply, `requires`, the required package and the constraint its version has to satisfy. Both
+// constraints may contain whitespace.
+package monorepo
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+const requires = "requires"
+
+// Requirement is a requirement of a package on another package, which applies when the version of the
+// package satisfies When.
+type Requirement struct {
+	Package    string
+	When       *semver.Constraint
+	Requires   string
+	Constraint *semver.Constraint
+}
+
+func (r *Requirement) String() string {
+	return r.Package + " " + r.When.String() + " " + requires + " " + r.Requires + " " + r.Constraint.String()
+}
+
+// Violation is a requirement that the manifest doesn't meet. Version is nil when the required package
+// is missing.
+type Violation struct {
+	Requirement *Requirement
+	Version     *semver.Version
+}
+
+func (v *Violation) Error() string {
+	if v.Version == nil {
+		return v.Requirement.String() + ": `" + v.Requirement.Requires + "` is missing"
+	}
+	return v.Requirement.String() + ": `" + v.Requirement.Requires + "` is at `" + v.Version.Original() + "`"
+}
+
+// Manifest holds the versions of the packages of a monorepo and their requirements.
+type Manifest struct {
+	semver       *semver.Semver
+	versions     map[string]*semver.Version
+	requirements []*Requirement
+}
+
+// New returns an empty manifest that validates versions and constraints with s.
+func New(s *semver.Semver) *Manifest {
+	return &Manifest{semver: s, versions: map[string]*semver.Version{}}
+}
+
+// Read reads a manifest and validates every line with s.
+func Read(s *semver.Semver, r io.Reader) (*Manifest, error) {
+	m := New(s)
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		i := indexOf(fields, requires)
+		switch {
+		case i < 0 && len(fields) == 2:
+			if _, ok := m.versions[fields[0]]; ok {
+				return nil, errors.Errorf("line %d sets `%s` again", line, fields[0])
+			}
+			if err := m.Set(fields[0], fields[1]); err != nil {
+				return nil, errors.Annotatef(err, "line %d", line)
+			}
+		case i >= 2 && len(fields) >= i+3:
+			err := m.Require(fields[0], strings.Join(fields[1:i], " "), fields[i+1], strings.Join(fields[i+2:], " "))
+			if err != nil {
+				return nil, errors.Annotatef(err, "line %d", line)
+			}
+		default:
+			return nil, errors.Errorf("line %d should hold a package and version or a requirement", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return m, nil
+}
+
+func indexOf(fields []string, field string) int {
+	for k := range fields {
+		if fields[k] == field {
+			return k
+		}
+	}
+	return -1
+}
+
+func validName(name string) error {
+	if name == "" || name == requires || strings.ContainsAny(name, " \t\r\n#") {
+		return errors.Errorf("package name `%s` is invalid", name)
+	}
+	return nil
+}
+
+// Set sets the version of the package, replacing an existing one.
+func (m *Manifest) Set(name string, version string) error {
+	if err := validName(name); err != nil {
+		return errors.Trace(err)
+	}
+	v, err := m.semver.Parse(version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	m.versions[name] = v
+	return nil
+}
+
+// Version returns the version of the package.
+func (m *Manifest) Version(name string) (*semver.Version, error) {
+	v, ok := m.versions[name]
+	if !ok {
+		return nil, errors.NotFoundf("package `%s`", name)
+	}
+	return v, nil
+}
+
+// Packages returns the names of the packages, sorted.
+func (m *Manifest) Packages() []string {
+	names := make([]string, 0, len(m.versions))
+	for name := range m.versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Require adds a requirement of the package on the version of another package, which applies when the
+// version of the package satisfies when, like `*` for always.
+func (m *Manifest) Require(name string, when string, required string, constraint string) error {
+	if err := validName(name); err != nil {
+		return errors.Trace(err)
+	}
+	if err := validName(required); err != nil {
+		return errors.Trace(err)
+	}
+	if name == required {
+		return errors.Errorf("package `%s` can't require itself", name)
+	}
+	whenConstraint, err := m.semver.ParseConstraint(when)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	requiredConstraint, err := m.semver.ParseConstraint(constraint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	m.requirements = append(m.requirements, &Requirement{
+		Package:    name,
+		When:       whenConstraint,
+		Requires:   required,
+		Constraint: requiredConstraint,
+	})
+	return nil
+}
+
+// Requirements returns the requirements in the order they were added.
+func (m *Manifest) Requirements() []*Requirement {
+	return m.requirements
+}
+
+// Validate checks every requirement that applies and returns the violations in the order of the
+// requirements. Requirements of packages that aren't in the manifest don't apply.
+func (m *Manifest) Validate() []*Violation {
+	var violations []*Violation
+	for _, requirement := range m.requirements {
+		v, ok := m.versions[requirement.Package]
+		if !ok || !requirement.When.CheckVersion(v) {
+			continue
+		}
+		required, ok := m.versions[requirement.Requires]
+		if !ok {
+			violations = append(violations, &Violation{Requirement: requirement})
+			continue
+		}
+		if !requirement.Constraint.CheckVersion(required) {
+			violations = append(violations, &Violation{Requirement: requirement, Version: required})
+		}
+	}
+	return violations
+}
+
+// Write writes the packages sorted by name, followed by the requirements in the order they were added.
+// Versions are written with their build metadata and constraints in their normalized form.
+func (m *Manifest) Write(w io.Writer) error {
+	for _, name := range m.Packages() {
+		version := m.versions[name].StringWithOptions(semver.StringOptions{IncludeBuild: true})
+		if _, err := io.WriteString(w, name+" "+version+"\n"); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for _, requirement := range m.requirements {
+		if _, err := io.WriteString(w, requirement.String()+"\n"); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
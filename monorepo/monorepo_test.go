@@ -0,0 +1,112 @@
+package monorepo_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/monorepo"
+	"github.com/juju/errors"
+)
+
+const manifest = `# packages
+api 2.3.0
+core 1.3.2+b.4
+web 0.9.0
+
+api ^2.0.0 requires core >=1.4.0
+api <2.0.0 requires core >=1.0.0
+web * requires api >=2.0.0 <3.0.0
+web * requires auth ^1.0.0
+cli * requires core >=1.0.0
+`
+
+func TestValidate(t *testing.T) {
+	s := semver.MustNew()
+	m, err := monorepo.Read(s, strings.NewReader(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	violations := m.Validate()
+	expected := []string{
+		"api ^2.0.0 requires core >=1.4.0: `core` is at `1.3.2+b.4`",
+		"web * requires auth ^1.0.0: `auth` is missing",
+	}
+	if len(violations) != len(expected) {
+		t.Fatalf("expected %d violations, got %v", len(expected), violations)
+	}
+	for k := range violations {
+		if violations[k].Error() != expected[k] {
+			t.Fatalf("expected `%s`, got `%s`", expected[k], violations[k].Error())
+		}
+	}
+	if err := m.Set("core", "1.4.0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Set("auth", "1.1.0"); err != nil {
+		t.Fatal(err)
+	}
+	if violations := m.Validate(); len(violations) != 0 {
+		t.Fatalf("expected no violations, got %v", violations)
+	}
+	v, err := m.Version("core")
+	if err != nil || v.Original() != "1.4.0" {
+		t.Fatalf("expected `1.4.0`, got %v, %v", v, err)
+	}
+	if _, err := m.Version("cli"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	s := semver.MustNew()
+	m, err := monorepo.Read(s, strings.NewReader(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := m.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := "api 2.3.0\ncore 1.3.2+b.4\nweb 0.9.0\n" +
+		"api ^2.0.0 requires core >=1.4.0\napi <2.0.0 requires core >=1.0.0\n" +
+		"web * requires api >=2.0.0 <3.0.0\nweb * requires auth ^1.0.0\ncli * requires core >=1.0.0\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+	again, err := monorepo.Read(s, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Packages()) != 3 || len(again.Requirements()) != 5 {
+		t.Fatalf("expected 3 packages and 5 requirements after a round-trip, got %d and %d",
+			len(again.Packages()), len(again.Requirements()))
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{"invalid version", "api 2.3\n"},
+		{"duplicate", "api 2.3.0\napi 2.4.0\n"},
+		{"fields", "api 2.3.0 extra\n"},
+		{"no when", "api requires core >=1.4.0\n"},
+		{"no constraint", "api * requires core\n"},
+		{"invalid when", "api ^2.a requires core >=1.4.0\n"},
+		{"invalid constraint", "api * requires core >=1.a\n"},
+		{"self", "api * requires api >=1.0.0\n"},
+		{"reserved name", "requires 1.0.0\n"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			if _, err := monorepo.Read(s, strings.NewReader(c.input)); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+}
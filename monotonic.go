@@ -0,0 +1,44 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// Violation is a version in a release history that doesn't increase.
+type Violation struct {
+	// Index is the index of the version in the history.
+	Index   int
+	Version string
+	// Previous is the highest version released before it.
+	Previous string
+	Reason   string
+}
+
+// CheckMonotonic checks that every version of the chronological release history is higher than all
+// versions released before it, and returns a Violation for every one that isn't. Versions are compared
+// by precedence, so re-releasing a version with other build metadata is a violation as well.
+func (s *Semver) CheckMonotonic(versions []string) ([]Violation, error) {
+	var violations []Violation
+	var highest *Version
+	for k := range versions {
+		v, err := s.Parse(versions[k])
+		if err != nil {
+			return nil, errors.Annotatef(err, "history index %d", k)
+		}
+		if highest == nil {
+			highest = v
+			continue
+		}
+		switch c := v.Compare(highest); {
+		case c > 0:
+			highest = v
+		case c == 0:
+			violations = append(violations, Violation{Index: k, Version: versions[k], Previous: highest.Original(),
+				Reason: "was already released"})
+		default:
+			violations = append(violations, Violation{Index: k, Version: versions[k], Previous: highest.Original(),
+				Reason: "is lower than a previous release"})
+		}
+	}
+	return violations, nil
+}
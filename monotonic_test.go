@@ -0,0 +1,62 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestCheckMonotonic(t *testing.T) {
+	cases := []struct {
+		name       string
+		versions   []string
+		violations []semver.Violation
+	}{
+		{"empty", nil, nil},
+		{"increasing", []string{"0.1.0", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0"}, nil},
+		{"decrease", []string{"1.0.0", "1.2.0", "1.1.0", "1.3.0"}, []semver.Violation{
+			{Index: 2, Version: "1.1.0", Previous: "1.2.0", Reason: "is lower than a previous release"},
+		}},
+		{"below-highest", []string{"1.0.0", "3.0.0", "2.0.0", "2.1.0", "3.0.1"}, []semver.Violation{
+			{Index: 2, Version: "2.0.0", Previous: "3.0.0", Reason: "is lower than a previous release"},
+			{Index: 3, Version: "2.1.0", Previous: "3.0.0", Reason: "is lower than a previous release"},
+		}},
+		{"prerelease-after-release", []string{"1.0.0", "1.0.0-rc.2"}, []semver.Violation{
+			{Index: 1, Version: "1.0.0-rc.2", Previous: "1.0.0", Reason: "is lower than a previous release"},
+		}},
+		{"duplicate", []string{"1.0.0+build.1", "1.0.0+build.2"}, []semver.Violation{
+			{Index: 1, Version: "1.0.0+build.2", Previous: "1.0.0+build.1", Reason: "was already released"},
+		}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run("check-monotonic-"+c.name, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			violations, err := semver.CheckMonotonic(c.versions)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if len(violations) != len(c.violations) {
+				t2.Fatalf("expected %d violations, got %v", len(c.violations), violations)
+			}
+			for i := range violations {
+				if violations[i] != c.violations[i] {
+					t2.Fatalf("expected violation %v, got %v", c.violations[i], violations[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckMonotonicErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.CheckMonotonic([]string{"1.0.0", invalidVersions[0]}); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
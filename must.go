@@ -0,0 +1,26 @@
+package semver
+
+// MustNew is like New, but panics when an option fails. It's meant for package-level variables and
+// tests, where the options are known to be valid.
+func MustNew(options ...Option) *Semver {
+	s, err := New(options...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// MustParse parses the version by the semver 2.0.0 spec without options, and panics when it's invalid.
+// It's meant for package-level variables and tests, where the version is known to be valid.
+func MustParse(version string) *Version {
+	return defaultSemver.MustParse(version)
+}
+
+// MustParse is like Parse, but panics when the version is invalid.
+func (s *Semver) MustParse(version string) *Version {
+	v, err := s.Parse(version)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,42 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var minimum = semver.MustParse("1.2.3-rc.1")
+
+func TestMustNew(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch())
+	if v := s.MustParse("1:1.0.0"); v.Epoch != 1 {
+		t.Fatalf("expected epoch 1, got %d", v.Epoch)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for an invalid option")
+		}
+	}()
+	semver.MustNew(semver.WithMaxLength(0))
+}
+
+func TestMustParse(t *testing.T) {
+	if minimum.Major != 1 || minimum.Minor != 2 || minimum.Patch != 3 || minimum.Prerelease != "rc.1" {
+		t.Fatalf("unexpected parsed version %s", minimum)
+	}
+	cases := []func(){
+		func() { semver.MustParse("1.2") },
+		func() { semver.MustNew().MustParse("01.2.3") },
+	}
+	for k := range cases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected case %d to panic", k)
+				}
+			}()
+			cases[k]()
+		}()
+	}
+}
@@ -0,0 +1,193 @@
+// Package mvs implements minimal version selection like Go modules do, with requirements
+// expressed through semver constraints.
+package mvs
+
+import (
+	"sort"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+// Module is a module at a specific version.
+type Module struct {
+	Path    string
+	Version string
+}
+
+// Requirement is a dependency on a module, constrained like `^1.2.0`.
+type Requirement struct {
+	Path       string
+	Constraint string
+}
+
+// Registry provides the available versions of modules and what each version requires.
+type Registry interface {
+	Versions(path string) ([]string, error)
+	Requirements(module Module) ([]Requirement, error)
+}
+
+// MemoryRegistry is a Registry kept in memory, mapping a module path and version to its requirements.
+type MemoryRegistry map[string]map[string][]Requirement
+
+// Versions returns the available versions of the module.
+func (r MemoryRegistry) Versions(path string) ([]string, error) {
+	versions, ok := r[path]
+	if !ok {
+		return nil, errors.NotFoundf("module `%s`", path)
+	}
+	result := make([]string, 0, len(versions))
+	for version := range versions {
+		result = append(result, version)
+	}
+	return result, nil
+}
+
+// Requirements returns what the module version requires.
+func (r MemoryRegistry) Requirements(module Module) ([]Requirement, error) {
+	requirements, ok := r[module.Path][module.Version]
+	if !ok {
+		return nil, errors.NotFoundf("module `%s` at `%s`", module.Path, module.Version)
+	}
+	return requirements, nil
+}
+
+// Resolver selects module versions from a registry.
+type Resolver struct {
+	semver   *semver.Semver
+	registry Registry
+}
+
+// New returns a new instance of Resolver.
+func New(semver *semver.Semver, registry Registry) *Resolver {
+	return &Resolver{
+		semver:   semver,
+		registry: registry,
+	}
+}
+
+// Resolve computes the build list for the requirements, ordered by module path. Every requirement
+// contributes the lowest version that satisfies its constraint, preferring stable versions, and the
+// highest contribution of every module is selected. The requirements of selected versions are
+// followed the same way. Resolving fails when a selected version doesn't satisfy a constraint,
+// like when one module requires `^1.0.0` and another `>=2.0.0` of the same module. A panic of the
+// registry fails with a *semver.HookPanicError as cause.
+func (r *Resolver) Resolve(requirements []Requirement) ([]Module, error) {
+	selected := map[string]*semver.Version{}
+	queue := append([]Requirement{}, requirements...)
+	for len(queue) > 0 {
+		requirement := queue[0]
+		queue = queue[1:]
+		minimum, err := r.minimum(requirement)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if current, ok := selected[requirement.Path]; ok && minimum.Compare(current) <= 0 {
+			continue
+		}
+		selected[requirement.Path] = minimum
+		next, err := r.requirements(Module{Path: requirement.Path, Version: minimum.Original()})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		queue = append(queue, next...)
+	}
+
+	modules := make([]Module, 0, len(selected))
+	for path, v := range selected {
+		modules = append(modules, Module{Path: path, Version: v.Original()})
+	}
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Path < modules[j].Path
+	})
+	if err := r.verify(requirements, selected, modules); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return modules, nil
+}
+
+// minimum returns the lowest version that satisfies the requirement. Prereleases are only
+// considered when no stable version does.
+func (r *Resolver) minimum(requirement Requirement) (*semver.Version, error) {
+	constraint, err := r.semver.ParseConstraint(requirement.Constraint)
+	if err != nil {
+		return nil, errors.Annotatef(err, "module `%s`", requirement.Path)
+	}
+	versions, err := r.versions(requirement.Path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var minimum, minimumPrerelease *semver.Version
+	for k := range versions {
+		v, err := r.semver.Parse(versions[k])
+		if err != nil {
+			return nil, errors.Annotatef(err, "module `%s`", requirement.Path)
+		}
+		if !constraint.CheckVersion(v) {
+			continue
+		}
+		if v.Prerelease == "" && (minimum == nil || v.Compare(minimum) < 0) {
+			minimum = v
+		}
+		if v.Prerelease != "" && (minimumPrerelease == nil || v.Compare(minimumPrerelease) < 0) {
+			minimumPrerelease = v
+		}
+	}
+	if minimum == nil {
+		minimum = minimumPrerelease
+	}
+	if minimum == nil {
+		return nil, errors.NotFoundf("version of module `%s` satisfying `%s`", requirement.Path, requirement.Constraint)
+	}
+	return minimum, nil
+}
+
+// verify checks that the selected versions satisfy the requirements and those of the selected modules.
+func (r *Resolver) verify(requirements []Requirement, selected map[string]*semver.Version, modules []Module) error {
+	check := func(requirer string, requirements []Requirement) error {
+		for k := range requirements {
+			constraint, err := r.semver.ParseConstraint(requirements[k].Constraint)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			v := selected[requirements[k].Path]
+			if !constraint.CheckVersion(v) {
+				return errors.Errorf("%s requires `%s` to be `%s`, but `%s` is selected", requirer,
+					requirements[k].Path, requirements[k].Constraint, v.Original())
+			}
+		}
+		return nil
+	}
+	if err := check("the root", requirements); err != nil {
+		return errors.Trace(err)
+	}
+	for k := range modules {
+		next, err := r.requirements(modules[k])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := check("`"+modules[k].Path+"` at `"+modules[k].Version+"`", next); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// versions lists the versions of the module, turning a panic of the registry into an error.
+func (r *Resolver) versions(path string) (versions []string, err error) {
+	defer recoverRegistry(&err)
+	return r.registry.Versions(path)
+}
+
+// requirements lists the requirements of the module, turning a panic of the registry into an error.
+func (r *Resolver) requirements(module Module) (requirements []Requirement, err error) {
+	defer recoverRegistry(&err)
+	return r.registry.Requirements(module)
+}
+
+// recoverRegistry turns a panic of the registry into a *semver.HookPanicError in err. It has to be deferred.
+func recoverRegistry(err *error) {
+	if r := recover(); r != nil {
+		*err = errors.Trace(&semver.HookPanicError{Hook: "registry", Value: r})
+	}
+}
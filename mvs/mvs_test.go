@@ -0,0 +1,110 @@
+package mvs_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/mvs"
+	"github.com/juju/errors"
+)
+
+var registry = mvs.MemoryRegistry{
+	"a": {
+		"1.0.0": {{Path: "b", Constraint: ">=1.2.0"}, {Path: "c", Constraint: "^1.0.0"}},
+		"1.1.0": {{Path: "b", Constraint: ">=1.3.0"}},
+	},
+	"b": {
+		"1.1.0": nil,
+		"1.2.0": {{Path: "d", Constraint: ">=1.1.0"}},
+		"1.3.0": {{Path: "d", Constraint: ">=1.2.0"}},
+		"2.0.0": nil,
+	},
+	"c": {
+		"1.0.0-rc.1": nil,
+		"1.2.0":      {{Path: "d", Constraint: ">=1.3.0"}},
+		"1.3.0":      {{Path: "d", Constraint: ">=1.4.0"}},
+	},
+	"d": {
+		"1.1.0": nil,
+		"1.2.0": nil,
+		"1.3.0": nil,
+		"1.4.0": nil,
+	},
+	"e": {
+		"0.1.0-beta.1": {{Path: "b", Constraint: "<1.2.0"}},
+		"0.1.0-beta.2": nil,
+	},
+}
+
+func newResolver(t *testing.T) *mvs.Resolver {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mvs.New(s, registry)
+}
+
+func buildList(modules []mvs.Module) string {
+	list := make([]string, len(modules))
+	for k := range modules {
+		list[k] = modules[k].Path + "@" + modules[k].Version
+	}
+	return strings.Join(list, " ")
+}
+
+func TestResolve(t *testing.T) {
+	resolver := newResolver(t)
+	cases := []struct {
+		requirements []mvs.Requirement
+		expected     string
+	}{
+		{[]mvs.Requirement{{Path: "a", Constraint: "1.0.0"}}, "a@1.0.0 b@1.2.0 c@1.2.0 d@1.3.0"},
+		{[]mvs.Requirement{{Path: "a", Constraint: "^1.0.0"}}, "a@1.0.0 b@1.2.0 c@1.2.0 d@1.3.0"},
+		{[]mvs.Requirement{{Path: "a", Constraint: ">=1.1.0"}}, "a@1.1.0 b@1.3.0 d@1.2.0"},
+		{[]mvs.Requirement{{Path: "a", Constraint: "1.0.0"}, {Path: "d", Constraint: ">=1.4.0"}},
+			"a@1.0.0 b@1.2.0 c@1.2.0 d@1.4.0"},
+		{[]mvs.Requirement{{Path: "e", Constraint: ">=0.1.0-beta.1"}}, "b@1.1.0 e@0.1.0-beta.1"},
+	}
+	for k := range cases {
+		modules, err := resolver.Resolve(cases[k].requirements)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if list := buildList(modules); list != cases[k].expected {
+			t.Fatalf("expected `%s`, got `%s`", cases[k].expected, list)
+		}
+	}
+}
+
+func TestResolveErrors(t *testing.T) {
+	resolver := newResolver(t)
+	_, err := resolver.Resolve([]mvs.Requirement{{Path: "a", Constraint: "1.0.0"}, {Path: "b", Constraint: "<1.2.0"}})
+	if err == nil || !strings.Contains(err.Error(), "the root requires `b` to be `<1.2.0`") {
+		t.Fatalf("expected a conflict error, got %v", err)
+	}
+	if _, err := resolver.Resolve([]mvs.Requirement{{Path: "a", Constraint: ">=5.0.0"}}); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if _, err := resolver.Resolve([]mvs.Requirement{{Path: "z", Constraint: "*"}}); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error for an unknown module, got %v", err)
+	}
+	if _, err := resolver.Resolve([]mvs.Requirement{{Path: "a", Constraint: ">>1"}}); err == nil {
+		t.Fatal("expected an error for an invalid constraint")
+	}
+}
+
+type panickingRegistry struct {
+	mvs.MemoryRegistry
+}
+
+func (panickingRegistry) Requirements(mvs.Module) ([]mvs.Requirement, error) { panic("requirements") }
+
+func TestResolvePanickingRegistry(t *testing.T) {
+	resolver := mvs.New(semver.MustNew(), panickingRegistry{registry})
+	_, err := resolver.Resolve([]mvs.Requirement{{Path: "a", Constraint: "1.0.0"}})
+	panicErr, ok := errors.Cause(err).(*semver.HookPanicError)
+	if !ok || panicErr.Hook != "registry" {
+		t.Fatalf("expected a registry *HookPanicError as cause, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// PrereleasePolicy decides if prereleases can be the outcome of a negotiation.
+type PrereleasePolicy int
+
+// The prerelease policies from strictest to most lenient.
+const (
+	// PrereleaseNever only agrees on releases.
+	PrereleaseNever PrereleasePolicy = iota
+	// PrereleaseFallback agrees on a prerelease only when there's no release in common.
+	PrereleaseFallback
+	// PrereleaseAllow agrees on the highest version in common, prerelease or not.
+	PrereleaseAllow
+)
+
+// Negotiate returns the highest release both the client and the server support, as listed by the
+// server. Versions are matched by their precedence, so build metadata is ignored. It fails with a
+// NotFound error when they have no release in common.
+func (s *Semver) Negotiate(clientSupported []string, serverSupported []string) (string, error) {
+	version, err := s.NegotiateWithPolicy(clientSupported, serverSupported, PrereleaseNever)
+	return version, errors.Trace(err)
+}
+
+// NegotiateWithPolicy is like Negotiate, but the policy decides if prereleases can be agreed on.
+func (s *Semver) NegotiateWithPolicy(clientSupported []string, serverSupported []string,
+	policy PrereleasePolicy) (string, error) {
+	if policy < PrereleaseNever || policy > PrereleaseAllow {
+		return "", errors.Errorf("prerelease policy %d is invalid", policy)
+	}
+	client := map[Key]bool{}
+	for k := range clientSupported {
+		v, err := s.Parse(clientSupported[k])
+		if err != nil {
+			return "", errors.Annotate(err, "client")
+		}
+		client[v.Key()] = true
+	}
+	var release, prerelease *Version
+	for k := range serverSupported {
+		v, err := s.Parse(serverSupported[k])
+		if err != nil {
+			return "", errors.Annotate(err, "server")
+		}
+		if !client[v.Key()] {
+			continue
+		}
+		if v.Prerelease == "" {
+			if release == nil || v.Compare(release) > 0 {
+				release = v
+			}
+		} else if prerelease == nil || v.Compare(prerelease) > 0 {
+			prerelease = v
+		}
+	}
+	switch {
+	case policy == PrereleaseAllow && prerelease != nil && (release == nil || prerelease.Compare(release) > 0):
+		return prerelease.Original(), nil
+	case release != nil:
+		return release.Original(), nil
+	case policy == PrereleaseFallback && prerelease != nil:
+		return prerelease.Original(), nil
+	}
+	return "", errors.NotFoundf("version both the client and the server support")
+}
@@ -0,0 +1,68 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestNegotiate(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name     string
+		client   []string
+		server   []string
+		policy   semver.PrereleasePolicy
+		expected string
+	}{
+		{"highest common", []string{"1.0.0", "1.1.0", "2.0.0"}, []string{"1.1.0", "1.0.0", "3.0.0"}, semver.PrereleaseNever, "1.1.0"},
+		{"build ignored", []string{"1.1.0+client"}, []string{"1.1.0+server"}, semver.PrereleaseNever, "1.1.0+server"},
+		{"never", []string{"1.0.0", "2.0.0-rc.1"}, []string{"1.0.0", "2.0.0-rc.1"}, semver.PrereleaseNever, "1.0.0"},
+		{"allow", []string{"1.0.0", "2.0.0-rc.1"}, []string{"1.0.0", "2.0.0-rc.1"}, semver.PrereleaseAllow, "2.0.0-rc.1"},
+		{"allow lower", []string{"1.0.0", "1.0.0-rc.1"}, []string{"1.0.0", "1.0.0-rc.1"}, semver.PrereleaseAllow, "1.0.0"},
+		{"fallback with release", []string{"1.0.0", "2.0.0-rc.1"}, []string{"1.0.0", "2.0.0-rc.1"}, semver.PrereleaseFallback, "1.0.0"},
+		{"fallback", []string{"2.0.0-rc.1", "2.0.0-rc.2"}, []string{"2.0.0-rc.2", "2.0.0-rc.1"}, semver.PrereleaseFallback, "2.0.0-rc.2"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			result, err := s.NegotiateWithPolicy(c.client, c.server, c.policy)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if result != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, result)
+			}
+		})
+	}
+	result, err := s.Negotiate([]string{"1.0.0", "1.2.0"}, []string{"1.2.0", "1.3.0"})
+	if err != nil || result != "1.2.0" {
+		t.Fatalf("expected `1.2.0`, got `%s` (%v)", result, err)
+	}
+}
+
+func TestNegotiateErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Negotiate([]string{"1.0.0"}, []string{"2.0.0"}); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error without common versions, got %v", err)
+	}
+	if _, err := s.Negotiate([]string{"2.0.0-rc.1"}, []string{"2.0.0-rc.1"}); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error with only prereleases in common, got %v", err)
+	}
+	if _, err := s.Negotiate([]string{"1.0"}, []string{"1.0.0"}); err == nil {
+		t.Fatal("expected an error for an invalid client version")
+	}
+	if _, err := s.Negotiate([]string{"1.0.0"}, []string{"1.0"}); err == nil {
+		t.Fatal("expected an error for an invalid server version")
+	}
+	if _, err := s.NegotiateWithPolicy(nil, nil, 3); err == nil {
+		t.Fatal("expected an error for an invalid policy")
+	}
+}
@@ -0,0 +1,169 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Options are the defaults a Semver validates, parses and compares versions by. They apply to every
+// method of the Semver and to the versions and constraints it parses, so one configured instance serves
+// all comparisons of an app. The With methods, like CompareWith, can override them per call with
+// CallOptions. The zero value follows the semver 2.0.0 spec, and the npm convention for prereleases in
+// constraints.
+type Options struct {
+	// VPrefix accepts versions with a leading `v`, like `v1.2.3`.
+	VPrefix bool
+	// IncludePrerelease lets prereleases satisfy constraints like any other version, instead of
+	// following the npm convention, see WithIncludePrerelease.
+	IncludePrerelease bool
+	// StrictBuildMeta orders versions of equal precedence by their build metadata, where no build
+	// metadata is the lowest, instead of ignoring it like the spec says.
+	StrictBuildMeta bool
+	// Scheme is the versioning scheme to validate and compare by. Only SchemeSemver versions can be
+	// parsed, so other schemes don't support Parse and constraints.
+	Scheme Scheme
+}
+
+// WithOptions sets the default Options of the Semver. It replaces WithIncludePrerelease.
+func WithOptions(options Options) Option {
+	return func(s *Semver) error {
+		return errors.Trace(s.setOptions(options))
+	}
+}
+
+func (s *Semver) setOptions(options Options) error {
+	var scheme *schemeVersioning
+	if options.Scheme != SchemeSemver {
+		var err error
+		if scheme, err = schemeFor(options.Scheme); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	s.vPrefix = options.VPrefix
+	s.includePrerelease = options.IncludePrerelease
+	s.strictBuild = options.StrictBuildMeta
+	s.scheme = options.Scheme
+	s.schemeVersioning = scheme
+	return nil
+}
+
+// Options returns the default Options of the Semver.
+func (s *Semver) Options() Options {
+	return Options{
+		VPrefix:           s.vPrefix,
+		IncludePrerelease: s.includePrerelease,
+		StrictBuildMeta:   s.strictBuild,
+		Scheme:            s.scheme,
+	}
+}
+
+// CallOption overrides one of the default Options for a single call.
+type CallOption func(o *Options)
+
+// VPrefix overrides Options.VPrefix.
+func VPrefix(allow bool) CallOption {
+	return func(o *Options) {
+		o.VPrefix = allow
+	}
+}
+
+// IncludePrerelease overrides Options.IncludePrerelease.
+func IncludePrerelease(include bool) CallOption {
+	return func(o *Options) {
+		o.IncludePrerelease = include
+	}
+}
+
+// StrictBuildMeta overrides Options.StrictBuildMeta.
+func StrictBuildMeta(strict bool) CallOption {
+	return func(o *Options) {
+		o.StrictBuildMeta = strict
+	}
+}
+
+// UseScheme overrides Options.Scheme.
+func UseScheme(scheme Scheme) CallOption {
+	return func(o *Options) {
+		o.Scheme = scheme
+	}
+}
+
+// scoped returns a copy of the Semver with the default Options overridden by the call options.
+func (s *Semver) scoped(overrides []CallOption) (*Semver, error) {
+	if len(overrides) == 0 {
+		return s, nil
+	}
+	options := s.Options()
+	for _, override := range overrides {
+		override(&options)
+	}
+	scoped := *s
+	// Constraints refer to the Semver that parsed them, so the copy can't share the cache.
+	scoped.constraints = nil
+	if err := scoped.setOptions(options); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &scoped, nil
+}
+
+// CompareWith compares like Compare, with the default Options overridden by the call options.
+func (s *Semver) CompareWith(version string, compare string, overrides ...CallOption) (int, error) {
+	scoped, err := s.scoped(overrides)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	c, err := scoped.compare(version, compare)
+	return c, errors.Trace(err)
+}
+
+// EqualWith checks like Equal, with the default Options overridden by the call options.
+func (s *Semver) EqualWith(a string, b string, overrides ...CallOption) (bool, error) {
+	scoped, err := s.scoped(overrides)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	equal, err := scoped.Equal(a, b)
+	return equal, errors.Trace(err)
+}
+
+// SatisfiesWith checks if the version satisfies the constraint expression, with the default Options
+// overridden by the call options.
+func (s *Semver) SatisfiesWith(version string, expression string, overrides ...CallOption) (bool, error) {
+	scoped, err := s.scoped(overrides)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	c, err := scoped.ParseConstraint(expression)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	ok, err := c.Check(version)
+	return ok, errors.Trace(err)
+}
+
+// trimPrefix drops the leading `v` of the version when the Semver accepts it.
+func (s *Semver) trimPrefix(version string) string {
+	if s.vPrefix {
+		return strings.TrimPrefix(version, "v")
+	}
+	return version
+}
+
+// notSupported is the error for parsing with a Semver that has a scheme other than SchemeSemver.
+func (s *Semver) notSupported() error {
+	return errors.NotSupportedf("parsing %s versions", s.schemeVersioning.name)
+}
+
+// compareBuild orders build metadata like prereleases, except that no build metadata is the lowest.
+func compareBuild(a string, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return -1
+	case b == "":
+		return 1
+	}
+	return comparePrerelease(a, b)
+}
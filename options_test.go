@@ -0,0 +1,144 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestOptions(t *testing.T) {
+	if options := semver.MustNew().Options(); options != (semver.Options{}) {
+		t.Fatalf("expected the zero options, got %+v", options)
+	}
+	options := semver.Options{VPrefix: true, IncludePrerelease: true, StrictBuildMeta: true}
+	s := semver.MustNew(semver.WithOptions(options))
+	if s.Options() != options {
+		t.Fatalf("expected %+v, got %+v", options, s.Options())
+	}
+
+	tests := []struct {
+		name      string
+		version   string
+		compare   string
+		overrides []semver.CallOption
+		expected  int
+	}{
+		{"VPrefix", "v1.2.3", "1.2.4", nil, -1},
+		{"BuildMeta", "1.2.3+b.2", "1.2.3+b.10", nil, -1},
+		{"NoBuildMeta", "1.2.3", "1.2.3+b.1", nil, -1},
+		{"IgnoreBuildMeta", "1.2.3+b.2", "1.2.3+b.10", []semver.CallOption{semver.StrictBuildMeta(false)}, 0},
+		{"Scheme", "1.0rc1", "1.0", []semver.CallOption{semver.UseScheme(semver.SchemePEP440)}, -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t2 *testing.T) {
+			c, err := s.CompareWith(test.version, test.compare, test.overrides...)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if c != test.expected {
+				t2.Fatalf("expected %d, got %d", test.expected, c)
+			}
+		})
+	}
+
+	if c, err := s.Compare("v1.2.3+b", "1.2.3"); err != nil || c != 1 {
+		t.Fatalf("expected Compare to use the default options, got %d, %v", c, err)
+	}
+	if _, err := s.CompareWith("v1.2.3", "1.2.3", semver.VPrefix(false)); err == nil {
+		t.Fatal("expected an error for a `v` prefix without VPrefix")
+	}
+	if equal, err := s.EqualWith("1.2.3+a", "v1.2.3+b", semver.StrictBuildMeta(false)); err != nil || !equal {
+		t.Fatalf("expected the versions to be equal, got %t, %v", equal, err)
+	}
+	if equal, err := s.Equal("1.2.3+a", "1.2.3+b"); err != nil || equal {
+		t.Fatalf("expected the versions not to be equal, got %t, %v", equal, err)
+	}
+	if equal, err := semver.MustNew(semver.WithOptions(semver.Options{VPrefix: true})).Equal("1.0.0+a",
+		"v1.0.0+b"); err != nil || !equal {
+		t.Fatalf("expected the zero options to ignore build metadata, got %t, %v", equal, err)
+	}
+}
+
+func TestOptionsEveryMethod(t *testing.T) {
+	s := semver.MustNew(semver.WithOptions(semver.Options{VPrefix: true, StrictBuildMeta: true}))
+	if !s.Valid("v1.2.3") {
+		t.Fatal("expected `v1.2.3` to be valid")
+	}
+	v, err := s.Parse("v1.2.3+b.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Original() != "v1.2.3+b.2" || v.Major != 1 {
+		t.Fatalf("unexpected parsed version %+v", v)
+	}
+	if v.Compare(s.MustParse("1.2.3+b.10")) != -1 {
+		t.Fatal("expected Version.Compare to order by build metadata")
+	}
+	if greater, err := s.GreaterThanOrEqual("v1.2.3", "1.0.0"); err != nil || !greater {
+		t.Fatalf("expected `v1.2.3` to be greater, got %t, %v", greater, err)
+	}
+	if inRange, err := s.InRange("v1.2.3+b", "v1.2.3", "1.2.3+c"); err != nil || !inRange {
+		t.Fatalf("expected `v1.2.3+b` to be in range, got %t, %v", inRange, err)
+	}
+	constraint, err := s.ParseConstraint("^v1.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := constraint.Check("v1.4.0"); err != nil || !ok {
+		t.Fatalf("expected `v1.4.0` to satisfy `^v1.2`, got %t, %v", ok, err)
+	}
+
+	pep440 := semver.MustNew(semver.WithOptions(semver.Options{Scheme: semver.SchemePEP440}))
+	if !pep440.Valid("1.0rc1") {
+		t.Fatal("expected `1.0rc1` to be valid by PEP 440")
+	}
+	if greater, err := pep440.GreaterThanOrEqual("1.0", "1.0rc1"); err != nil || !greater {
+		t.Fatalf("expected `1.0` to be greater than `1.0rc1`, got %t, %v", greater, err)
+	}
+	if inRange, err := pep440.InRange("1.0rc1", "1.0a1", "1.0"); err != nil || !inRange {
+		t.Fatalf("expected `1.0rc1` to be in range, got %t, %v", inRange, err)
+	}
+	if _, err := pep440.Parse("1.0"); !errors.IsNotSupported(err) {
+		t.Fatalf("expected a NotSupported error, got %v", err)
+	}
+	if _, err := pep440.ParseConstraint(">=1.0"); !errors.IsNotSupported(err) {
+		t.Fatalf("expected a NotSupported error, got %v", err)
+	}
+}
+
+func TestSatisfiesWith(t *testing.T) {
+	s := semver.MustNew(semver.WithOptions(semver.Options{VPrefix: true}),
+		semver.WithConstraintCache(8))
+	tests := []struct {
+		name       string
+		version    string
+		expression string
+		overrides  []semver.CallOption
+		expected   bool
+	}{
+		{"Release", "v1.2.0", "^1.0.0", nil, true},
+		{"ExcludedPrerelease", "v1.3.0-rc.1", "^1.0.0", nil, false},
+		{"IncludedPrerelease", "v1.3.0-rc.1", "^1.0.0", []semver.CallOption{semver.IncludePrerelease(true)}, true},
+		{"CachedExcludedPrerelease", "v1.3.0-rc.1", "^1.0.0", nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t2 *testing.T) {
+			satisfies, err := s.SatisfiesWith(test.version, test.expression, test.overrides...)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if satisfies != test.expected {
+				t2.Fatalf("expected %t, got %t", test.expected, satisfies)
+			}
+		})
+	}
+
+	_, err := s.SatisfiesWith("1.0", ">=1.0", semver.UseScheme(semver.SchemePEP440))
+	if !errors.IsNotSupported(err) {
+		t.Fatalf("expected a NotSupported error, got %v", err)
+	}
+	if _, err := semver.New(semver.WithOptions(semver.Options{Scheme: semver.Scheme(99)})); err == nil {
+		t.Fatal("expected an error for an unknown scheme")
+	}
+}
@@ -0,0 +1,110 @@
+package semver
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// The OSV range events.
+const (
+	eventIntroduced   = "introduced"
+	eventFixed        = "fixed"
+	eventLastAffected = "last_affected"
+	eventLimit        = "limit"
+)
+
+type osvEvent struct {
+	kind    string
+	version *Version
+}
+
+// Affected checks whether the version is in any of the vulnerable ranges of an OSV advisory. A range
+// holds events separated by whitespace or commas, like `introduced=1.0.0 fixed=1.2.5`, of the kinds
+// `introduced`, `fixed`, `last_affected` and `limit`, where `introduced=0` is every version. Like the OSV
+// spec the events are evaluated in version order by precedence, so prereleases are affected when they
+// sort between the events: `1.2.5-rc.1` is affected by a range fixed in `1.2.5`.
+func (s *Semver) Affected(version string, vulnerableRanges []string) (bool, error) {
+	v, err := s.Parse(version)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	var affected bool
+	for _, vulnerableRange := range vulnerableRanges {
+		events, limit, err := s.parseOSVRange(vulnerableRange)
+		if err != nil {
+			return false, errors.Annotatef(err, "range `%s`", vulnerableRange)
+		}
+		if limit != nil && v.Compare(limit) >= 0 {
+			continue
+		}
+		if osvAffected(v, events) {
+			affected = true
+		}
+	}
+	return affected, nil
+}
+
+func (s *Semver) parseOSVRange(vulnerableRange string) ([]osvEvent, *Version, error) {
+	fields := strings.FieldsFunc(vulnerableRange, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+	var events []osvEvent
+	var limit *Version
+	var introduced bool
+	for _, field := range fields {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 {
+			return nil, nil, errors.Errorf("event `%s` should be like `introduced=1.0.0`", field)
+		}
+		event := osvEvent{kind: parts[0]}
+		if event.kind == eventIntroduced && parts[1] == "0" {
+			// `0` affects every version, so it starts at the lowest one.
+			event.version = &Version{Prerelease: "0"}
+		} else {
+			var err error
+			if event.version, err = s.Parse(parts[1]); err != nil {
+				return nil, nil, errors.Annotatef(err, "event `%s`", field)
+			}
+		}
+		switch event.kind {
+		case eventIntroduced:
+			introduced = true
+		case eventFixed, eventLastAffected:
+		case eventLimit:
+			if limit == nil || event.version.Compare(limit) < 0 {
+				limit = event.version
+			}
+			continue
+		default:
+			return nil, nil, errors.NotSupportedf("event `%s`", event.kind)
+		}
+		events = append(events, event)
+	}
+	if !introduced {
+		return nil, nil, errors.New("range has no `introduced` event")
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].version.Compare(events[j].version) < 0
+	})
+	return events, limit, nil
+}
+
+// osvAffected evaluates the sorted events for the version, where the last event at or below the version
+// decides whether it's affected.
+func osvAffected(v *Version, events []osvEvent) bool {
+	var affected bool
+	for _, event := range events {
+		c := v.Compare(event.version)
+		switch {
+		case event.kind == eventIntroduced && c >= 0:
+			affected = true
+		case event.kind == eventFixed && c >= 0:
+			affected = false
+		case event.kind == eventLastAffected && c > 0:
+			affected = false
+		}
+	}
+	return affected
+}
@@ -0,0 +1,67 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestAffected(t *testing.T) {
+	s := semver.MustNew()
+	ranges := []string{"introduced=1.0.0 fixed=1.2.5", "introduced=2.0.0-0, last_affected=2.1.0"}
+	tests := []struct {
+		name     string
+		version  string
+		ranges   []string
+		expected bool
+	}{
+		{"BeforeIntroduced", "0.9.0", ranges, false},
+		{"Introduced", "1.0.0", ranges, true},
+		{"Between", "1.2.4", ranges, true},
+		{"PrereleaseOfFix", "1.2.5-rc.1", ranges, true},
+		{"Fixed", "1.2.5", ranges, false},
+		{"AfterFixed", "1.9.0", ranges, false},
+		{"PrereleaseOfIntroduced", "2.0.0-beta.1", ranges, true},
+		{"LastAffected", "2.1.0", ranges, true},
+		{"AfterLastAffected", "2.1.1", ranges, false},
+		{"IntroducedZero", "0.0.1", []string{"introduced=0 fixed=0.5.0"}, true},
+		{"IntroducedZeroPrerelease", "0.0.0-alpha", []string{"introduced=0 fixed=0.5.0"}, true},
+		{"Reintroduced", "3.1.0", []string{"introduced=1.0.0 fixed=2.0.0 introduced=3.0.0"}, true},
+		{"Limit", "4.0.0", []string{"introduced=1.0.0 limit=3.0.0"}, false},
+		{"BelowLimit", "2.0.0", []string{"introduced=1.0.0 limit=3.0.0"}, true},
+		{"NoRanges", "1.0.0", nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t2 *testing.T) {
+			affected, err := s.Affected(test.version, test.ranges)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if affected != test.expected {
+				t2.Fatalf("expected %t for `%s`, got %t", test.expected, test.version, affected)
+			}
+		})
+	}
+}
+
+func TestAffectedInvalid(t *testing.T) {
+	s := semver.MustNew()
+	tests := []struct {
+		name    string
+		version string
+		ranges  []string
+	}{
+		{"Version", "1.0", []string{"introduced=0"}},
+		{"Event", "1.0.0", []string{"introduced"}},
+		{"EventVersion", "1.0.0", []string{"introduced=1.x"}},
+		{"UnknownEvent", "1.0.0", []string{"introduced=0 patched=1.0.0"}},
+		{"NotIntroduced", "1.0.0", []string{"fixed=1.0.0"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t2 *testing.T) {
+			if _, err := s.Affected(test.version, test.ranges); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// parsePartial parses a partial version like `1.2`, `1.x` or `1.2.*` of a comparator, of which only
+// the leading parts are set. It returns false for versions that aren't partial.
+func (s *Semver) parsePartial(version string) (*Version, int, bool, error) {
+	original := version
+	version = s.trimPrefix(version)
+	v := &Version{}
+	epoch, rest, hasEpoch := s.splitEpoch(version)
+	if hasEpoch {
+		version = rest
+	}
+	if version == "" || strings.ContainsAny(version, "-+") {
+		return nil, 0, false, nil
+	}
+	fields := strings.Split(version, ".")
+	if len(fields) > len(coreParts) {
+		return nil, 0, false, nil
+	}
+	set := len(fields)
+	for k := range fields {
+		if fields[k] == "x" || fields[k] == "X" || fields[k] == "*" {
+			if set == len(fields) {
+				set = k
+			}
+		} else if set < len(fields) || !validNumericIdentifier(fields[k]) {
+			return nil, 0, false, nil
+		}
+	}
+	if set == len(coreParts) {
+		return nil, 0, false, nil
+	}
+	var err error
+	if hasEpoch {
+		if !validNumericIdentifier(epoch) {
+			return nil, 0, false, nil
+		}
+		if v.Epoch, err = atoiPart(original, "epoch", epoch); err != nil {
+			return nil, 0, true, errors.Trace(err)
+		}
+	}
+	parts := []*int{&v.Major, &v.Minor, &v.Patch}
+	for k := 0; k < set; k++ {
+		if *parts[k], err = atoiPart(original, coreParts[k], fields[k]); err != nil {
+			return nil, 0, true, errors.Trace(err)
+		}
+	}
+	v.original = original
+	v.maxSegment = s.maxSegment
+	v.strictBuild = s.strictBuild
+	return v, set, true, nil
+}
+
+// partialComparators expands a comparator with a partial version into comparators with full versions
+// by the npm rules, where the parts that aren't set match any value. So `1.2` is `>=1.2.0 <1.3.0-0`,
+// `<=1.2` is `<1.3.0-0`, `>1.2` is `>=1.3.0` and `^1.2` is `^1.2.0`.
+func partialComparators(operator Operator, v *Version, set int) ([]*Comparator, error) {
+	if set == 0 {
+		switch operator {
+		case OperatorEqual, OperatorGreaterThanOrEqual, OperatorLessThanOrEqual, OperatorTilde, OperatorCaret:
+			return []*Comparator{{Operator: OperatorAny}}, nil
+		}
+		return nil, errors.Errorf("comparator `%s%s` can't be satisfied", operator, v.original)
+	}
+	upper, err := v.increment([]Bump{BumpMajor, BumpMinor}[set-1])
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	lowest := &Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Prerelease: "0"}
+	upper.Epoch = v.Epoch
+	upperPrerelease := upper.core()
+	upperPrerelease.Prerelease = "0"
+	line := []*Comparator{
+		{Operator: OperatorGreaterThanOrEqual, Version: v},
+		{Operator: OperatorLessThan, Version: upperPrerelease},
+	}
+	switch operator {
+	case OperatorEqual:
+		return line, nil
+	case OperatorGreaterThanOrEqual:
+		return line[:1], nil
+	case OperatorGreaterThan:
+		return []*Comparator{{Operator: OperatorGreaterThanOrEqual, Version: upper}}, nil
+	case OperatorLessThan:
+		return []*Comparator{{Operator: OperatorLessThan, Version: lowest}}, nil
+	case OperatorLessThanOrEqual:
+		return line[1:], nil
+	case OperatorCaret:
+		if v.Major == 0 && (set == 1 || v.Minor == 0) {
+			return line, nil
+		}
+	case OperatorTilde:
+		if set == 1 {
+			return line, nil
+		}
+	case OperatorNotEqual:
+		return nil, errors.NotSupportedf("comparator `%s%s` with a partial version", operator, v.original)
+	}
+	return []*Comparator{{Operator: operator, Version: v}}, nil
+}
@@ -0,0 +1,75 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestPartialConstraint(t *testing.T) {
+	s := semver.MustNew()
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"1.2", ">=1.2.0 <1.3.0-0"},
+		{"1.2.x", ">=1.2.0 <1.3.0-0"},
+		{"=1.X.*", ">=1.0.0 <2.0.0-0"},
+		{"1", ">=1.0.0 <2.0.0-0"},
+		{"x", "*"},
+		{">=1.2", ">=1.2.0"},
+		{">1.2", ">=1.3.0"},
+		{"<1.2", "<1.2.0-0"},
+		{"<=1.2", "<1.3.0-0"},
+		{"^1.2", "^1.2.0"},
+		{"^0.2", "^0.2.0"},
+		{"^0.0", ">=0.0.0 <0.1.0-0"},
+		{"^0", ">=0.0.0 <1.0.0-0"},
+		{"~1.2", "~1.2.0"},
+		{"~1", ">=1.0.0 <2.0.0-0"},
+		{"1.2 || >= 2", ">=1.2.0 <1.3.0-0 || >=2.0.0"},
+	}
+	for _, test := range tests {
+		t.Run(test.expression, func(t2 *testing.T) {
+			constraint, err := s.ParseConstraint(test.expression)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if constraint.String() != test.expected {
+				t2.Fatalf("expected `%s`, got `%s`", test.expected, constraint.String())
+			}
+		})
+	}
+
+	checks := []struct {
+		expression string
+		version    string
+		expected   bool
+	}{
+		{"1.2", "1.2.0", true},
+		{"1.2", "1.2.9", true},
+		{"1.2", "1.3.0-rc.1", false},
+		{"1.2", "1.3.0", false},
+		{"<=1.2", "1.2.99", true},
+		{">1.2", "1.2.99", false},
+	}
+	for _, check := range checks {
+		constraint, err := s.ParseConstraint(check.expression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := constraint.Check(check.version); err != nil || ok != check.expected {
+			t.Fatalf("expected `%s` to be %t for `%s`, got %t, %v", check.expression, check.expected, check.version,
+				ok, err)
+		}
+	}
+}
+
+func TestPartialConstraintErrors(t *testing.T) {
+	s := semver.MustNew()
+	for _, expression := range []string{"!=1.2", ">x", "<*", "1.x.3", "01.2", "1.2-rc.1", "1.99999999999999999999"} {
+		if _, err := s.ParseConstraint(expression); err == nil {
+			t.Fatalf("expected `%s` to be an invalid constraint", expression)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package semver
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Pattern is the regular expression of the semver 2.0.0 grammar that versions are validated against.
+const Pattern = validPattern
+
+// WithPattern adds a pattern that versions have to match on top of the semver grammar, for organizations
+// with a stricter grammar, like a mandatory `-build.N` prerelease. The pattern is matched against the
+// whole version as given, so it should be anchored. It applies to every version that's passed in to be
+// validated, parsed or compared, including the bounds of InRange, but not to the operands of constraints.
+func WithPattern(re *regexp.Regexp) Option {
+	return func(s *Semver) error {
+		if re == nil {
+			return errors.New("pattern can't be nil")
+		}
+		s.pattern = re
+		return nil
+	}
+}
+
+// grammarGroups are the named groups of a grammar, in the order they make up the semver notation.
+var grammarGroups = []string{"major", "minor", "patch", "prerelease", "build"}
+
+// grammar is a replacement of the semver grammar, see WithGrammar.
+type grammar struct {
+	re *regexp.Regexp
+	// groups are the indexes of grammarGroups in the submatches, -1 for the ones the grammar doesn't have.
+	groups []int
+}
+
+// WithGrammar replaces the semver grammar with the pattern, for organizations with an extended grammar
+// like `1.2.3_rc1`. The pattern has to match the whole version and have the named groups `major`,
+// `minor` and `patch`, and can have `prerelease` and `build`. The groups are compared like their
+// semver counterparts, so their values should follow the semver grammar of those parts, except that
+// numbers can have leading zeros. Like WithPattern it applies to the versions that are passed in, while
+// the operands of constraints stay semver, so `>=1.2.3-0` matches `1.2.3_rc1`. Functions that rewrite
+// version strings, like WithBuildMetadata, still expect the semver notation.
+func WithGrammar(re *regexp.Regexp) Option {
+	return func(s *Semver) error {
+		if re == nil {
+			return errors.New("grammar can't be nil")
+		}
+		g := &grammar{re: re, groups: make([]int, len(grammarGroups))}
+		for k, name := range grammarGroups {
+			g.groups[k] = re.SubexpIndex(name)
+			if g.groups[k] < 0 && k < len(coreParts) {
+				return errors.Errorf("grammar `%s` should have a group named `%s`", re, name)
+			}
+		}
+		s.grammar = g
+		return nil
+	}
+}
+
+func (s *Semver) matchesPattern(version string) bool {
+	return s.pattern == nil || s.pattern.MatchString(s.trimPrefix(version))
+}
+
+// input checks a version that's passed in against the pattern and converts it from the grammar of
+// WithGrammar to the semver notation. Constraint operands and the versions built internally skip it.
+func (s *Semver) input(version string) (string, error) {
+	if !s.matchesPattern(version) {
+		return "", errors.Errorf("version `%s` doesn't match the pattern", version)
+	}
+	if s.grammar == nil {
+		return version, nil
+	}
+	converted, ok := s.grammar.convert(version)
+	if !ok {
+		return "", errors.Errorf("version `%s` doesn't match the grammar", version)
+	}
+	return converted, nil
+}
+
+// convert converts the version to the semver notation. It's false when the grammar doesn't match all of it.
+func (g *grammar) convert(version string) (string, bool) {
+	matches := g.re.FindStringSubmatch(version)
+	if matches == nil || matches[0] != version {
+		return "", false
+	}
+	var b strings.Builder
+	for k := range coreParts {
+		if k > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(trimLeadingZeros(matches[g.groups[k]]))
+	}
+	separators := []string{"-", "+"}
+	for k, index := range g.groups[len(coreParts):] {
+		if index >= 0 && matches[index] != "" {
+			b.WriteString(separators[k] + matches[index])
+		}
+	}
+	return b.String(), true
+}
+
+// trimLeadingZeros drops the leading zeros of a number, so `007` becomes `7`. Anything else is left as is.
+func trimLeadingZeros(number string) string {
+	if !isNumeric(number) {
+		return number
+	}
+	if trimmed := strings.TrimLeft(number, "0"); trimmed != "" {
+		return trimmed
+	}
+	return "0"
+}
@@ -0,0 +1,164 @@
+package semver_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestWithPattern(t *testing.T) {
+	s := semver.MustNew(semver.WithPattern(regexp.MustCompile(`-build\.\d+$`)))
+	cases := []struct {
+		version string
+		valid   bool
+	}{
+		{"1.2.3-build.4", true},
+		{"1.2.3-build.4+sha.5114f85", false},
+		{"2.0.0-build.12", true},
+		{"1.2.3", false},
+		{"1.2.3-rc.1", false},
+		{"1.2-build.4", false},
+		{"01.2.3-build.4", false},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version, func(t2 *testing.T) {
+			if valid := s.Valid(c.version); valid != c.valid {
+				t2.Fatalf("expected valid %t, got %t", c.valid, valid)
+			}
+			if _, err := s.Parse(c.version); (err == nil) != c.valid {
+				t2.Fatalf("expected valid %t, got error %v", c.valid, err)
+			}
+		})
+	}
+	result, err := s.Compare("1.2.3-build.10", "1.2.3-build.9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != 1 {
+		t.Fatalf("expected 1, got %d", result)
+	}
+	if _, err := s.Compare("1.2.3-build.10", "1.2.3"); err == nil {
+		t.Fatal("expected comparing a version that doesn't match the pattern to fail")
+	}
+	if _, err := s.GreaterThanOrEqual("1.2.3", "1.2.3-build.9"); err == nil {
+		t.Fatal("expected comparing a version that doesn't match the pattern to fail")
+	}
+	if _, _, _, err := s.Distance("1.2.3-build.1", "1.2.4"); err == nil {
+		t.Fatal("expected the distance to a version that doesn't match the pattern to fail")
+	}
+	compare := s.CompareFunc()
+	if compare("1.2.3", "1.0.0-build.1") != -1 {
+		t.Fatal("expected CompareFunc to order a version that doesn't match the pattern first")
+	}
+}
+
+func TestWithPatternConstraint(t *testing.T) {
+	s := semver.MustNew(semver.WithPattern(regexp.MustCompile(`-build\.\d+$`)), semver.WithIncludePrerelease())
+	constraint, err := s.ParseConstraint(">=1.0.0 <2.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		version   string
+		satisfies bool
+		valid     bool
+	}{
+		{"1.2.3-build.4", true, true},
+		{"2.0.1-build.1", false, true},
+		{"1.2.3", false, false},
+	}
+	for k := range cases {
+		c := cases[k]
+		satisfies, err := constraint.Check(c.version)
+		if (err == nil) != c.valid {
+			t.Fatalf("expected `%s` valid %t, got error %v", c.version, c.valid, err)
+		}
+		if satisfies != c.satisfies {
+			t.Fatalf("expected `%s` to satisfy %t, got %t", c.version, c.satisfies, satisfies)
+		}
+	}
+	inRange, err := s.InRange("1.2.3-build.4", "1.0.0-build.0", "2.0.0-build.0")
+	if err != nil || !inRange {
+		t.Fatalf("expected `1.2.3-build.4` to be in range, got %v, %v", inRange, err)
+	}
+	if _, err := s.InRange("1.2.3-build.4", "1.0.0", "2.0.0"); err == nil {
+		t.Fatal("expected bounds that don't match the pattern to fail")
+	}
+}
+
+func TestWithGrammar(t *testing.T) {
+	s := semver.MustNew(semver.WithGrammar(regexp.MustCompile(
+		`^(?P<major>\d+)\.(?P<minor>\d+)\.(?P<patch>\d+)(?:_(?P<prerelease>[a-z]+\d*))?(?:@(?P<build>[a-z0-9]+))?$`)))
+	cases := []struct {
+		version  string
+		valid    bool
+		expected string
+	}{
+		{"1.2.3", true, "1.2.3"},
+		{"1.2.3_rc1", true, "1.2.3-rc1"},
+		{"01.002.3_beta@sha5114f85", true, "1.2.3-beta+sha5114f85"},
+		{"1.2.3-rc.1", false, ""},
+		{"1.2", false, ""},
+		{"1.2.3_RC1", false, ""},
+		{"1.2.99999999999999999999", false, ""},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version, func(t2 *testing.T) {
+			if valid := s.Valid(c.version); valid != c.valid {
+				t2.Fatalf("expected valid %t, got %t", c.valid, valid)
+			}
+			v, err := s.Parse(c.version)
+			if (err == nil) != c.valid {
+				t2.Fatalf("expected valid %t, got error %v", c.valid, err)
+			}
+			if err != nil {
+				return
+			}
+			if v.String() != c.expected || v.Original() != c.version {
+				t2.Fatalf("expected `%s` from `%s`, got `%s` from `%s`", c.expected, c.version, v, v.Original())
+			}
+		})
+	}
+	result, err := s.Compare("1.2.3_rc2", "1.2.3_rc10")
+	if err != nil || result != 1 {
+		t.Fatalf("expected `rc2` to be higher than `rc10` by ASCII, got %d, %v", result, err)
+	}
+	constraint, err := s.ParseConstraint(">=1.2.3-0 <2.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if satisfies, err := constraint.Check("1.2.3_rc1"); err != nil || !satisfies {
+		t.Fatalf("expected `1.2.3_rc1` to satisfy `%s`, got %v, %v", constraint, satisfies, err)
+	}
+	if _, err := s.Compare("1.2.3-rc.1", "1.2.3"); err == nil {
+		t.Fatal("expected a semver prerelease not to match the grammar")
+	}
+}
+
+func TestWithGrammarErrors(t *testing.T) {
+	if _, err := semver.New(semver.WithGrammar(nil)); err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, err := semver.New(semver.WithGrammar(regexp.MustCompile(`^(?P<major>\d+)\.(?P<minor>\d+)$`))); err == nil {
+		t.Fatal("expected an error for a grammar without a patch")
+	}
+}
+
+func TestWithPatternNil(t *testing.T) {
+	if _, err := semver.New(semver.WithPattern(nil)); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestPattern(t *testing.T) {
+	re := regexp.MustCompile(semver.Pattern)
+	if !re.MatchString("1.2.3-rc.1+build.5") {
+		t.Fatal("expected the pattern to match")
+	}
+	if re.MatchString("1.2") {
+		t.Fatal("expected the pattern not to match")
+	}
+}
@@ -0,0 +1,160 @@
+package semver
+
+import (
+	"regexp"
+	"strings"
+)
+
+var rePEP440 = regexp.MustCompile(`(?i)^\s*v?(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(a|b|c|rc|alpha|beta|pre|preview)[-_.]?(\d*))?` +
+	`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d*))?` +
+	`(?:[-_.]?(dev)[-_.]?(\d*))?` +
+	`(?:\+([a-z0-9]+(?:[-_.][a-z0-9]+)*))?\s*$`)
+
+// pep440Phases orders the prerelease phases. Alternative spellings are normalized first.
+var pep440Phases = map[string]int{"a": 0, "b": 1, "rc": 2}
+
+type pep440Version struct {
+	epoch   string
+	release []string
+	// phase is -1 for dev releases without a prerelease, which sort before any prerelease,
+	// and 3 for versions that aren't a prerelease at all.
+	phase   int
+	pre     string
+	hasPost bool
+	post    string
+	hasDev  bool
+	dev     string
+	local   []string
+}
+
+func validPEP440(version string) bool {
+	return rePEP440.MatchString(version)
+}
+
+func parsePEP440(version string) *pep440Version {
+	m := rePEP440.FindStringSubmatch(version)
+	v := &pep440Version{epoch: m[1], phase: len(pep440Phases)}
+	v.release = strings.Split(m[2], ".")
+	// Trailing zeros don't matter, so `1.0` equals `1.0.0`.
+	for len(v.release) > 1 && isZeros(v.release[len(v.release)-1]) {
+		v.release = v.release[:len(v.release)-1]
+	}
+	if m[3] != "" {
+		switch phase := strings.ToLower(m[3]); phase {
+		case "alpha":
+			v.phase = pep440Phases["a"]
+		case "beta":
+			v.phase = pep440Phases["b"]
+		case "c", "pre", "preview":
+			v.phase = pep440Phases["rc"]
+		default:
+			v.phase = pep440Phases[phase]
+		}
+		v.pre = m[4]
+	}
+	if m[5] != "" || m[6] != "" {
+		v.hasPost = true
+		v.post = m[5] + m[7]
+	}
+	if m[8] != "" {
+		v.hasDev = true
+		v.dev = m[9]
+		if m[3] == "" && !v.hasPost {
+			v.phase = -1
+		}
+	}
+	if m[10] != "" {
+		v.local = strings.FieldsFunc(strings.ToLower(m[10]), func(r rune) bool {
+			return r == '-' || r == '_' || r == '.'
+		})
+	}
+	return v
+}
+
+// comparePEP440 compares two valid PEP 440 versions: by epoch, release, prerelease, post release,
+// dev release and finally the local version label.
+func comparePEP440(a string, b string) int {
+	vA := parsePEP440(a)
+	vB := parsePEP440(b)
+	if c := compareNumericStrings(vA.epoch, vB.epoch); c != 0 {
+		return c
+	}
+	for k := 0; k < len(vA.release) || k < len(vB.release); k++ {
+		var partA, partB string
+		if k < len(vA.release) {
+			partA = vA.release[k]
+		}
+		if k < len(vB.release) {
+			partB = vB.release[k]
+		}
+		if c := compareNumericStrings(partA, partB); c != 0 {
+			return c
+		}
+	}
+	if c := compareInts(vA.phase, vB.phase); c != 0 {
+		return c
+	}
+	if c := compareNumericStrings(vA.pre, vB.pre); c != 0 {
+		return c
+	}
+	if c := compareOptional(vA.hasPost, vA.post, vB.hasPost, vB.post, false); c != 0 {
+		return c
+	}
+	if c := compareOptional(vA.hasDev, vA.dev, vB.hasDev, vB.dev, true); c != 0 {
+		return c
+	}
+	return comparePEP440Local(vA.local, vB.local)
+}
+
+// compareOptional compares an optional numbered segment. A missing segment sorts after a present
+// one when missingIsHigher, otherwise before it.
+func compareOptional(hasA bool, a string, hasB bool, b string, missingIsHigher bool) int {
+	switch {
+	case hasA && hasB:
+		return compareNumericStrings(a, b)
+	case hasA == hasB:
+		return 0
+	case hasA == missingIsHigher:
+		return -1
+	}
+	return 1
+}
+
+// comparePEP440Local compares local version labels. Numeric segments sort after alphanumeric ones
+// and a version without a label sorts before one with a label.
+func comparePEP440Local(a []string, b []string) int {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		aNumeric, bNumeric := isNumeric(a[k]), isNumeric(b[k])
+		switch {
+		case aNumeric && bNumeric:
+			if c := compareNumericStrings(a[k], b[k]); c != 0 {
+				return c
+			}
+		case aNumeric:
+			return 1
+		case bNumeric:
+			return -1
+		default:
+			if c := strings.Compare(a[k], b[k]); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareInts(len(a), len(b))
+}
+
+// compareNumericStrings compares two unsigned decimal strings of any length. Empty strings and
+// leading zeros count as zero.
+func compareNumericStrings(a string, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if c := compareInts(len(a), len(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+func isZeros(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
@@ -0,0 +1,96 @@
+package semver
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// SupportState is the support state of a version under a Policy.
+type SupportState int
+
+// The support states from most to least supported.
+const (
+	SupportStateSupported SupportState = iota
+	SupportStateDeprecated
+	SupportStateEndOfLife
+)
+
+var supportStateNames = []string{"supported", "deprecated", "end of life"}
+
+func (s SupportState) String() string {
+	if s < SupportStateSupported || s > SupportStateEndOfLife {
+		return "unknown"
+	}
+	return supportStateNames[s]
+}
+
+// PolicyRule gives the versions satisfying the constraint a support state. The state advances to
+// deprecated and end of life when their times pass; zero times never pass.
+type PolicyRule struct {
+	Constraint   string
+	State        SupportState
+	DeprecatedAt time.Time
+	EndOfLifeAt  time.Time
+}
+
+type policyRule struct {
+	rule       PolicyRule
+	constraint *Constraint
+}
+
+// Policy maps version ranges to their support state, like `<1.4.0` being end of life and `^1.4.0` being
+// deprecated from a given date. Rules are evaluated in the order they were added and the first one that
+// the version satisfies applies.
+type Policy struct {
+	semver *Semver
+	rules  []policyRule
+}
+
+// NewPolicy returns a new, empty instance of Policy.
+func NewPolicy(semver *Semver) *Policy {
+	return &Policy{semver: semver}
+}
+
+// Add adds the rule after the existing rules.
+func (p *Policy) Add(rule PolicyRule) error {
+	if rule.State < SupportStateSupported || rule.State > SupportStateEndOfLife {
+		return errors.Errorf("support state %d is invalid", rule.State)
+	}
+	constraint, err := p.semver.ParseConstraint(rule.Constraint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	p.rules = append(p.rules, policyRule{rule: rule, constraint: constraint})
+	return nil
+}
+
+// Evaluate returns the support state of the version now.
+func (p *Policy) Evaluate(version string) (SupportState, error) {
+	state, err := p.EvaluateAt(version, time.Now())
+	return state, errors.Trace(err)
+}
+
+// EvaluateAt returns the support state of the version at the given time. It fails with a NotFound
+// error when no rule applies to the version.
+func (p *Policy) EvaluateAt(version string, at time.Time) (SupportState, error) {
+	v, err := p.semver.Parse(version)
+	if err != nil {
+		return SupportStateSupported, errors.Trace(err)
+	}
+	for k := range p.rules {
+		if !p.rules[k].constraint.CheckVersion(v) {
+			continue
+		}
+		rule := p.rules[k].rule
+		state := rule.State
+		if !rule.DeprecatedAt.IsZero() && !at.Before(rule.DeprecatedAt) && state < SupportStateDeprecated {
+			state = SupportStateDeprecated
+		}
+		if !rule.EndOfLifeAt.IsZero() && !at.Before(rule.EndOfLifeAt) {
+			state = SupportStateEndOfLife
+		}
+		return state, nil
+	}
+	return SupportStateSupported, errors.NotFoundf("policy rule for version `%s`", version)
+}
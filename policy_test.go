@@ -0,0 +1,87 @@
+package semver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestPolicy(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deprecatedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endOfLifeAt := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	policy := semver.NewPolicy(s)
+	rules := []semver.PolicyRule{
+		{Constraint: "<1.4.0", State: semver.SupportStateEndOfLife},
+		{Constraint: "^1.4.0", DeprecatedAt: deprecatedAt, EndOfLifeAt: endOfLifeAt},
+		{Constraint: "*"},
+	}
+	for k := range rules {
+		if err := policy.Add(rules[k]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cases := []struct {
+		version  string
+		at       time.Time
+		expected semver.SupportState
+	}{
+		{"1.3.9", deprecatedAt.AddDate(-1, 0, 0), semver.SupportStateEndOfLife},
+		{"1.4.2", deprecatedAt.AddDate(0, 0, -1), semver.SupportStateSupported},
+		{"1.4.2", deprecatedAt, semver.SupportStateDeprecated},
+		{"1.9.0", endOfLifeAt, semver.SupportStateEndOfLife},
+		{"2.0.0", endOfLifeAt, semver.SupportStateSupported},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version+" "+c.at.Format("2006-01-02"), func(t2 *testing.T) {
+			state, err := policy.EvaluateAt(c.version, c.at)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if state != c.expected {
+				t2.Fatalf("expected %s, got %s", c.expected, state)
+			}
+		})
+	}
+	if state, err := policy.Evaluate("1.4.2"); err != nil || state != semver.SupportStateEndOfLife {
+		t.Fatalf("expected `1.4.2` to be end of life now, got %s (%v)", state, err)
+	}
+}
+
+func TestPolicyErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := semver.NewPolicy(s)
+	if err := policy.Add(semver.PolicyRule{Constraint: "^1.a"}); err == nil {
+		t.Fatal("expected an error for an invalid constraint")
+	}
+	if err := policy.Add(semver.PolicyRule{Constraint: "^1.2.0", State: 5}); err == nil {
+		t.Fatal("expected an error for an invalid state")
+	}
+	if err := policy.Add(semver.PolicyRule{Constraint: "^1.2.0"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := policy.Evaluate("2.0.0"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error without a matching rule, got %v", err)
+	}
+	if _, err := policy.Evaluate("2.0"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
+
+func TestSupportStateString(t *testing.T) {
+	if result := semver.SupportStateEndOfLife.String(); result != "end of life" {
+		t.Fatalf("expected `end of life`, got `%s`", result)
+	}
+	if result := semver.SupportState(-1).String(); result != "unknown" {
+		t.Fatalf("expected `unknown`, got `%s`", result)
+	}
+}
@@ -0,0 +1,116 @@
+package semver
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+const (
+	defaultPollInterval    = time.Second
+	defaultPollMaxInterval = time.Minute
+	defaultPollMultiplier  = 2
+)
+
+// ReleaseSource lists the released versions of something, like a package registry or a git remote.
+type ReleaseSource interface {
+	Releases(ctx context.Context) ([]string, error)
+}
+
+// PollOptions configure how WaitForRelease polls its source. Zero values fall back to the defaults.
+type PollOptions struct {
+	// Interval is the delay before the first retry. Defaults to 1 second.
+	Interval time.Duration
+	// MaxInterval caps the delay between retries. Defaults to 1 minute.
+	MaxInterval time.Duration
+	// Multiplier grows the delay after every retry. Defaults to 2.
+	Multiplier float64
+	// Timeout stops polling after the given duration. Defaults to no timeout besides the context's.
+	Timeout time.Duration
+}
+
+// WaitForRelease polls the source until it lists a version that satisfies the constraint and isn't denied
+// and returns the highest one. Releases that aren't valid versions are skipped. Failing polls are
+// retried; when the timeout or context deadline passes a timeout error is returned, annotated with
+// the last poll error if there was one. A panic of the source fails at once with a *HookPanicError
+// as cause.
+func (s *Selector) WaitForRelease(ctx context.Context, source ReleaseSource, constraint *Constraint,
+	options PollOptions) (string, error) {
+	if constraint == nil {
+		return "", errors.New("constraint can't be nil")
+	}
+	if options.Interval <= 0 {
+		options.Interval = defaultPollInterval
+	}
+	if options.MaxInterval <= 0 {
+		options.MaxInterval = defaultPollMaxInterval
+	}
+	if options.Multiplier < 1 {
+		options.Multiplier = defaultPollMultiplier
+	}
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	interval := options.Interval
+	var lastErr error
+	for {
+		releases, err := pollReleases(ctx, source)
+		if _, ok := errors.Cause(err).(*HookPanicError); ok {
+			return "", errors.Trace(err)
+		}
+		if err != nil {
+			lastErr = err
+		} else if version, ok := s.satisfyingRelease(releases, constraint); ok {
+			return version, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if ctx.Err() != context.DeadlineExceeded {
+				return "", errors.Trace(ctx.Err())
+			}
+			if lastErr != nil {
+				return "", errors.NewTimeout(lastErr, "waiting for a release satisfying `"+constraint.String()+"`")
+			}
+			return "", errors.Timeoutf("waiting for a release satisfying `%s`", constraint)
+		case <-timer.C:
+		}
+		interval = time.Duration(float64(interval) * options.Multiplier)
+		if interval > options.MaxInterval {
+			interval = options.MaxInterval
+		}
+	}
+}
+
+// pollReleases lists the releases of the source, turning a panic of it into an error.
+func pollReleases(ctx context.Context, source ReleaseSource) (releases []string, err error) {
+	defer recoverHook("source", &err)
+	return source.Releases(ctx)
+}
+
+// satisfyingRelease returns the highest valid release that satisfies the constraint and isn't denied.
+func (s *Selector) satisfyingRelease(releases []string, constraint *Constraint) (string, bool) {
+	var selected *Version
+	for k := range releases {
+		v, err := s.semver.Parse(releases[k])
+		if err != nil {
+			continue
+		}
+		if _, denied := s.Denied(releases[k]); denied || !constraint.CheckVersion(v) {
+			continue
+		}
+		if selected == nil || v.Compare(selected) > 0 {
+			selected = v
+		}
+	}
+	if selected == nil {
+		return "", false
+	}
+	return selected.Original(), true
+}
@@ -0,0 +1,108 @@
+package semver_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+type fakeReleaseSource struct {
+	mutex    sync.Mutex
+	polls    int
+	releases [][]string
+	err      error
+}
+
+func (f *fakeReleaseSource) Releases(context.Context) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.polls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.polls > len(f.releases) {
+		return f.releases[len(f.releases)-1], nil
+	}
+	return f.releases[f.polls-1], nil
+}
+
+var fastPolling = semver.PollOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+func mustConstraint(t *testing.T, expression string) *semver.Constraint {
+	t.Helper()
+	constraint, err := semver.MustNew().ParseConstraint(expression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return constraint
+}
+
+func TestWaitForRelease(t *testing.T) {
+	selector := newSelector(t)
+	source := &fakeReleaseSource{releases: [][]string{
+		{"1.0.0"},
+		{"1.0.0", "1.3.0"},
+		{"1.0.0", "1.3.0", "1.4.0-rc.1"},
+		{"1.0.0", "1.3.0", "1.4.0-rc.1", "1.4.0"},
+	}}
+	version, err := selector.WaitForRelease(context.Background(), source, mustConstraint(t, ">=1.4.0-rc.1"), fastPolling)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "1.4.0-rc.1" || source.polls != 3 {
+		t.Fatalf("expected `1.4.0-rc.1` after 3 polls, got `%s` after %d", version, source.polls)
+	}
+}
+
+func TestWaitForReleaseSkipsDenied(t *testing.T) {
+	selector := newSelector(t)
+	source := &fakeReleaseSource{releases: [][]string{{"1.3.0"}, {"1.3.0", "1.3.1"}}}
+	version, err := selector.WaitForRelease(context.Background(), source, mustConstraint(t, ">=1.3.0"), fastPolling)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "1.3.1" {
+		t.Fatalf("expected the denied `1.3.0` to be skipped, got `%s`", version)
+	}
+}
+
+func TestWaitForReleaseTimeout(t *testing.T) {
+	selector := newSelector(t)
+	source := &fakeReleaseSource{releases: [][]string{{"1.0.0"}}}
+	options := fastPolling
+	options.Timeout = 20 * time.Millisecond
+	if _, err := selector.WaitForRelease(context.Background(), source, mustConstraint(t, ">=5.0.0"), options); !errors.IsTimeout(err) {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+
+	source = &fakeReleaseSource{err: errors.New("registry unavailable")}
+	_, err := selector.WaitForRelease(context.Background(), source, mustConstraint(t, ">=1.0.0"), options)
+	if !errors.IsTimeout(err) || source.polls < 2 {
+		t.Fatalf("expected a timeout error after retrying, got %v after %d polls", err, source.polls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := selector.WaitForRelease(ctx, source, mustConstraint(t, ">=1.0.0"), fastPolling); errors.Cause(err) != context.Canceled {
+		t.Fatalf("expected a canceled error, got %v", err)
+	}
+	if _, err := selector.WaitForRelease(ctx, source, nil, fastPolling); err == nil {
+		t.Fatal("expected an error for a nil constraint")
+	}
+}
+
+func TestWaitForReleaseSkipsInvalid(t *testing.T) {
+	selector := newSelector(t)
+	source := &fakeReleaseSource{releases: [][]string{{"latest", "1.1.0", "v2"}}}
+	version, err := selector.WaitForRelease(context.Background(), source, mustConstraint(t, "^1.0.0"), fastPolling)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "1.1.0" || source.polls != 1 {
+		t.Fatalf("expected `1.1.0` after 1 poll, got `%s` after %d", version, source.polls)
+	}
+}
@@ -0,0 +1,60 @@
+package semver
+
+import (
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// Pool interns parsed versions, so identical version strings share a single *Version instead of each
+// holding a copy. It's safe for concurrent use. The shared versions must not be modified.
+type Pool struct {
+	semver   *Semver
+	mutex    sync.RWMutex
+	versions map[string]*Version
+}
+
+// NewPool returns a new, empty instance of Pool that parses versions with semver.
+func NewPool(semver *Semver) *Pool {
+	return &Pool{
+		semver:   semver,
+		versions: map[string]*Version{},
+	}
+}
+
+// Intern returns the pooled version for the given version string, parsing and adding it first when
+// it isn't pooled yet. Only identical strings share a version, so `1.2.3+a` and `1.2.3+b` don't.
+func (p *Pool) Intern(version string) (*Version, error) {
+	p.mutex.RLock()
+	v, ok := p.versions[version]
+	p.mutex.RUnlock()
+	p.semver.cacheLookup("pool", ok)
+	if ok {
+		return v, nil
+	}
+	parsed, err := p.semver.Parse(version)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if v, ok := p.versions[version]; ok {
+		return v, nil
+	}
+	p.versions[version] = parsed
+	return parsed, nil
+}
+
+// Len returns the number of versions in the pool.
+func (p *Pool) Len() int {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return len(p.versions)
+}
+
+// Reset empties the pool. Versions that were handed out stay valid.
+func (p *Pool) Reset() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.versions = map[string]*Version{}
+}
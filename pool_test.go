@@ -0,0 +1,83 @@
+package semver_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestPool(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := semver.NewPool(s)
+	a, err := pool.Intern("1.2.3-rc.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := pool.Intern("1.2.3-rc.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Fatal("expected identical versions to share an instance")
+	}
+	c, err := pool.Intern("1.2.3-rc.1+build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c == a {
+		t.Fatal("expected a version with build metadata to have its own instance")
+	}
+	if pool.Len() != 2 {
+		t.Fatalf("expected 2 pooled versions, got %d", pool.Len())
+	}
+	if _, err := pool.Intern("1.2"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if pool.Len() != 2 {
+		t.Fatalf("expected invalid versions not to be pooled, got %d", pool.Len())
+	}
+	pool.Reset()
+	if pool.Len() != 0 {
+		t.Fatalf("expected an empty pool after resetting, got %d", pool.Len())
+	}
+}
+
+func TestPoolConcurrent(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := semver.NewPool(s)
+	results := make([]*semver.Version, 8)
+	var wg sync.WaitGroup
+	for k := range results {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				v, err := pool.Intern("1.0." + strconv.Itoa(i))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if i == 42 {
+					results[k] = v
+				}
+			}
+		}(k)
+	}
+	wg.Wait()
+	for k := range results {
+		if results[k] != results[0] {
+			t.Fatal("expected all goroutines to get the same instance")
+		}
+	}
+	if pool.Len() != 100 {
+		t.Fatalf("expected 100 pooled versions, got %d", pool.Len())
+	}
+}
@@ -0,0 +1,110 @@
+package semver
+
+// Predicate reports whether a version matches. Predicates can be combined with And, Or and Not
+// and used to select versions from collections and streams.
+type Predicate func(v *Version) bool
+
+// And matches when all predicates match. Without predicates it always matches.
+func And(predicates ...Predicate) Predicate {
+	return func(v *Version) bool {
+		for k := range predicates {
+			if !predicates[k](v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or matches when any of the predicates match. Without predicates it never matches.
+func Or(predicates ...Predicate) Predicate {
+	return func(v *Version) bool {
+		for k := range predicates {
+			if predicates[k](v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not matches when the predicate doesn't.
+func Not(predicate Predicate) Predicate {
+	return func(v *Version) bool {
+		return !predicate(v)
+	}
+}
+
+// Stable matches versions without a prerelease tag.
+func Stable() Predicate {
+	return func(v *Version) bool {
+		return v.Prerelease == ""
+	}
+}
+
+// NewerThan matches versions with a higher precedence than compare.
+func NewerThan(compare *Version) Predicate {
+	return func(v *Version) bool {
+		return v.Compare(compare) > 0
+	}
+}
+
+// OlderThan matches versions with a lower precedence than compare.
+func OlderThan(compare *Version) Predicate {
+	return func(v *Version) bool {
+		return v.Compare(compare) < 0
+	}
+}
+
+// Between matches versions between start and end, inclusive. Like an Unbounded bound for InRange,
+// a nil start or end leaves that side of the range open.
+func Between(start *Version, end *Version) Predicate {
+	return func(v *Version) bool {
+		return (start == nil || v.Compare(start) >= 0) && (end == nil || v.Compare(end) <= 0)
+	}
+}
+
+// InConstraint matches versions that satisfy the constraint.
+func InConstraint(c *Constraint) Predicate {
+	return c.CheckVersion
+}
+
+// Where returns a new collection with only the entries whose version matches the predicate. A panic of
+// the predicate fails with a *HookPanicError as cause instead of crashing the caller.
+func (c *Collection) Where(predicate Predicate) (filtered *Collection, err error) {
+	defer recoverHook("predicate", &err)
+	return c.filter(func(entry *Entry) bool {
+		return predicate(entry.Version)
+	}), nil
+}
+
+// FilterStream passes on the valid results from a stream that match the predicate and drops the rest.
+// A result for which the predicate panics is passed on as invalid, with a *HookPanicError as cause.
+// The returned channel is closed once results is.
+func FilterStream(results <-chan Result, predicate Predicate) <-chan Result {
+	filtered := make(chan Result)
+	go func() {
+		defer close(filtered)
+		for result := range results {
+			if !result.Valid {
+				continue
+			}
+			matches, err := matchPredicate(predicate, result.Version)
+			if err != nil {
+				result.Valid = false
+				result.Err = err
+				filtered <- result
+				continue
+			}
+			if matches {
+				filtered <- result
+			}
+		}
+	}()
+	return filtered
+}
+
+func matchPredicate(predicate Predicate, v *Version) (matches bool, err error) {
+	defer recoverHook("predicate", &err)
+	return predicate(v), nil
+}
@@ -0,0 +1,84 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestPredicates(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(version string) *semver.Version {
+		v, err := s.Parse(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	versions := []string{"0.9.0", "1.0.0-rc.1", "1.0.0", "1.2.0", "2.0.0-beta", "2.0.0"}
+	cases := []struct {
+		name      string
+		predicate semver.Predicate
+		expected  []string
+	}{
+		{"stable", semver.Stable(), []string{"0.9.0", "1.0.0", "1.2.0", "2.0.0"}},
+		{"not-stable", semver.Not(semver.Stable()), []string{"1.0.0-rc.1", "2.0.0-beta"}},
+		{"newer-than", semver.NewerThan(parse("1.0.0")), []string{"1.2.0", "2.0.0-beta", "2.0.0"}},
+		{"older-than", semver.OlderThan(parse("1.0.0")), []string{"0.9.0", "1.0.0-rc.1"}},
+		{"between", semver.Between(parse("1.0.0"), parse("2.0.0-beta")), []string{"1.0.0", "1.2.0", "2.0.0-beta"}},
+		{"between-unbounded", semver.Between(parse("2.0.0-beta"), nil), []string{"2.0.0-beta", "2.0.0"}},
+		{"between-unbounded-start", semver.Between(nil, parse("1.0.0-rc.1")), []string{"0.9.0", "1.0.0-rc.1"}},
+		{"and", semver.And(semver.Stable(), semver.NewerThan(parse("1.0.0"))), []string{"1.2.0", "2.0.0"}},
+		{"or", semver.Or(semver.OlderThan(parse("1.0.0-rc.1")), semver.NewerThan(parse("2.0.0-beta"))),
+			[]string{"0.9.0", "2.0.0"}},
+		{"and-empty", semver.And(), versions},
+		{"or-empty", semver.Or(), []string{}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			matched := []string{}
+			for _, version := range versions {
+				if c.predicate(parse(version)) {
+					matched = append(matched, version)
+				}
+			}
+			if strings.Join(matched, " ") != strings.Join(c.expected, " ") {
+				t2.Fatalf("expected %v, got %v", c.expected, matched)
+			}
+		})
+	}
+}
+
+func TestCollectionWhere(t *testing.T) {
+	collection, err := newCollection(t).Where(semver.Stable())
+	if err != nil {
+		t.Fatal(err)
+	}
+	originals := collectionOriginals(collection)
+	if strings.Join(originals, " ") != "1.0.0 1.1.0 1.2.0" {
+		t.Fatalf("unexpected stable versions %v", originals)
+	}
+}
+
+func TestFilterStream(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := s.ValidateStream(strings.NewReader("1.0.0\n1.1.0-rc.1\ninvalid\n1.1.0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	matched := []string{}
+	for result := range semver.FilterStream(results, semver.Stable()) {
+		matched = append(matched, result.Input)
+	}
+	if strings.Join(matched, " ") != "1.0.0 1.1.0" {
+		t.Fatalf("unexpected filtered results %v", matched)
+	}
+}
@@ -0,0 +1,51 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// MatchesPrefix validates the version and checks if its leading segments equal the prefix, like `1` or
+// `1.2`, so `1.2.7-rc.1` matches `1.2` but `1.20.0` doesn't. The prefix can have up to as many segments as
+// a version and, with WithEpoch, an epoch; a missing epoch is 0.
+func (s *Semver) MatchesPrefix(version string, prefix string) (bool, error) {
+	v, err := s.Parse(version)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	epoch := 0
+	if e, rest, ok := s.splitEpoch(prefix); ok {
+		if epoch, err = s.prefixPart(prefix, "epoch", e); err != nil {
+			return false, errors.Trace(err)
+		}
+		prefix = rest
+	}
+	segments := strings.Split(prefix, ".")
+	parts := []int{v.Major, v.Minor, v.Patch}
+	if s.quad {
+		parts = append(parts, v.Revision)
+	}
+	if len(segments) > len(parts) {
+		return false, errors.Errorf("prefix `%s` has more than %d segments", prefix, len(parts))
+	}
+	matches := epoch == v.Epoch
+	for k := range segments {
+		n, err := s.prefixPart(prefix, segmentNames[k], segments[k])
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		matches = matches && n == parts[k]
+	}
+	return matches, nil
+}
+
+var segmentNames = []string{"major", "minor", "patch", "revision"}
+
+func (s *Semver) prefixPart(prefix string, part string, value string) (int, error) {
+	if !validNumericIdentifier(value) {
+		return 0, errors.Errorf("prefix `%s` has invalid %s `%s`", prefix, part, value)
+	}
+	n, err := atoiPart(prefix, part, value)
+	return n, errors.Trace(err)
+}
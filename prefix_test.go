@@ -0,0 +1,64 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestMatchesPrefix(t *testing.T) {
+	s := semver.MustNew(semver.WithEpoch(), semver.WithQuadSegments())
+	cases := []struct {
+		version  string
+		prefix   string
+		expected bool
+	}{
+		{"1.2.7", "1", true},
+		{"1.2.7", "1.2", true},
+		{"1.2.7", "1.2.7", true},
+		{"1.2.7.3", "1.2.7.3", true},
+		{"1.2.7-rc.1+build.5", "1.2", true},
+		{"1.20.0", "1.2", false},
+		{"2.2.0", "1.2", false},
+		{"1.2.7", "1.2.8", false},
+		{"2:1.2.7", "1.2", false},
+		{"2:1.2.7", "2:1.2", true},
+		{"0:1.2.7", "1", true},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version+" "+c.prefix, func(t2 *testing.T) {
+			matches, err := s.MatchesPrefix(c.version, c.prefix)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if matches != c.expected {
+				t2.Fatalf("expected %t, got %t", c.expected, matches)
+			}
+		})
+	}
+}
+
+func TestMatchesPrefixErrors(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		version string
+		prefix  string
+	}{
+		{"1.2", "1"},
+		{"1.2.3", ""},
+		{"1.2.3", "1.*"},
+		{"1.2.3", "01"},
+		{"1.2.3", "1.2."},
+		{"1.2.3", "1.2.3.4"},
+		{"1.2.3", "99999999999999999999"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version+" "+c.prefix, func(t2 *testing.T) {
+			if _, err := s.MatchesPrefix(c.version, c.prefix); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+}
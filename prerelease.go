@@ -0,0 +1,84 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// BumpPrerelease increments the last numeric identifier of the version's prerelease, so `1.2.3-rc.1`
+// becomes `1.2.3-rc.2`. A prerelease ending in a non-numeric identifier gets `.1` appended, so `1.2.3-rc`
+// becomes `1.2.3-rc.1`. Build metadata is dropped. Stable versions can't be bumped.
+func (s *Semver) BumpPrerelease(version string) (string, error) {
+	v, err := s.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if v.Prerelease == "" {
+		return "", errors.Errorf("version `%s` is not a prerelease", version)
+	}
+	identifiers := strings.Split(v.Prerelease, ".")
+	last := len(identifiers) - 1
+	if isNumeric(identifiers[last]) {
+		identifiers[last] = incrementNumeric(identifiers[last])
+	} else {
+		identifiers = append(identifiers, "1")
+	}
+	bumped := v.core()
+	bumped.Prerelease = strings.Join(identifiers, ".")
+	return bumped.canonical(), nil
+}
+
+// NewPrerelease starts a prerelease series with the identifier. For a stable version it's the first
+// prerelease of the next minor, so `1.2.3` with `beta` becomes `1.3.0-beta.1`. For a prerelease it
+// moves to the identifier within the same release, so `1.3.0-alpha.4` becomes `1.3.0-beta.1`, which
+// has to be higher than the version itself. Build metadata is dropped.
+func (s *Semver) NewPrerelease(version string, identifier string) (string, error) {
+	v, err := s.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	next := v.core()
+	if v.Prerelease == "" {
+		if next, err = v.increment(BumpMinor); err != nil {
+			return "", errors.Trace(err)
+		}
+	}
+	next.Prerelease = identifier + ".1"
+	prerelease := next.canonical()
+	if !s.Valid(prerelease) {
+		return "", errors.Errorf("prerelease identifier `%s` is invalid", identifier)
+	}
+	if v.Prerelease != "" && next.Compare(v) <= 0 {
+		return "", errors.Errorf("prerelease `%s` is not higher than version `%s`", prerelease, version)
+	}
+	return prerelease, nil
+}
+
+// Promote turns the prerelease into its release by dropping the prerelease and build metadata, so
+// `1.2.3-rc.2` becomes `1.2.3`. Stable versions can't be promoted.
+func (s *Semver) Promote(version string) (string, error) {
+	v, err := s.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if v.Prerelease == "" {
+		return "", errors.Errorf("version `%s` is not a prerelease", version)
+	}
+	release := v.core()
+	return release.canonical(), nil
+}
+
+// incrementNumeric adds one to the numeric identifier. It works on the digits, so identifiers too
+// big to fit in an int are incremented as well.
+func incrementNumeric(identifier string) string {
+	digits := []byte(identifier)
+	for k := len(digits) - 1; k >= 0; k-- {
+		if digits[k] != '9' {
+			digits[k]++
+			return string(digits)
+		}
+		digits[k] = '0'
+	}
+	return "1" + string(digits)
+}
@@ -0,0 +1,155 @@
+package semver_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+var bumpPrereleaseVersions = []struct {
+	version  string
+	expected string
+}{
+	{"1.2.3-rc.1", "1.2.3-rc.2"},
+	{"1.2.3-rc", "1.2.3-rc.1"},
+	{"1.2.3-9", "1.2.3-10"},
+	{"1.2.3-beta.9.x", "1.2.3-beta.9.x.1"},
+	{"1.2.3-rc.1+build.5", "1.2.3-rc.2"},
+	{"1.2.3-rc.99999999999999999999", "1.2.3-rc.100000000000000000000"},
+}
+
+func TestBumpPrerelease(t *testing.T) {
+	for k := range bumpPrereleaseVersions {
+		b := bumpPrereleaseVersions[k]
+		t.Run("bump-prerelease-"+b.version, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			bumped, err := semver.BumpPrerelease(b.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if bumped != b.expected {
+				t2.Fatalf("expected `%s`, got `%s`", b.expected, bumped)
+			}
+		})
+	}
+}
+
+var newPrereleaseVersions = []struct {
+	version    string
+	identifier string
+	expected   string
+}{
+	{"1.2.3", "beta", "1.3.0-beta.1"},
+	{"0.9.0+build.1", "alpha", "0.10.0-alpha.1"},
+	{"1.3.0-alpha.4", "beta", "1.3.0-beta.1"},
+	{"1.3.0-beta.2", "rc", "1.3.0-rc.1"},
+}
+
+func TestNewPrerelease(t *testing.T) {
+	for k := range newPrereleaseVersions {
+		n := newPrereleaseVersions[k]
+		t.Run("new-prerelease-"+n.version+"_"+n.identifier, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			prerelease, err := semver.NewPrerelease(n.version, n.identifier)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if prerelease != n.expected {
+				t2.Fatalf("expected `%s`, got `%s`", n.expected, prerelease)
+			}
+		})
+	}
+}
+
+func TestPromote(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for version, expected := range map[string]string{
+		"1.2.3-rc.2":         "1.2.3",
+		"1.2.3-rc.2+build.5": "1.2.3",
+		"0.1.0-alpha":        "0.1.0",
+	} {
+		promoted, err := semver.Promote(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if promoted != expected {
+			t.Fatalf("expected `%s` to promote to `%s`, got `%s`", version, expected, promoted)
+		}
+	}
+}
+
+func TestPrereleaseWorkflow(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, err := semver.NewPrerelease("1.2.3", "rc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version, err = semver.BumpPrerelease(version); err != nil {
+		t.Fatal(err)
+	}
+	if version, err = semver.Promote(version); err != nil {
+		t.Fatal(err)
+	}
+	if version != "1.3.0" {
+		t.Fatalf("expected the workflow to end at `1.3.0`, got `%s`", version)
+	}
+}
+
+func TestPrereleaseErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.BumpPrerelease(invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := semver.BumpPrerelease("1.2.3"); err == nil {
+		t.Fatal("expected an error for bumping a stable version")
+	}
+	if _, err := semver.Promote(invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := semver.Promote("1.2.3"); err == nil {
+		t.Fatal("expected an error for promoting a stable version")
+	}
+	if _, err := semver.NewPrerelease(invalidVersions[0], "beta"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	for _, identifier := range []string{"", "01", "beta!", "rc..x"} {
+		if _, err := semver.NewPrerelease("1.2.3", identifier); err == nil {
+			t.Fatalf("expected an error for identifier `%s`", identifier)
+		}
+	}
+	if _, err := semver.NewPrerelease("1.3.0-beta.2", "alpha"); err == nil {
+		t.Fatal("expected an error for moving back to a lower prerelease")
+	}
+	if _, err := semver.NewPrerelease("1.3.0-beta.1", "beta"); err == nil {
+		t.Fatal("expected an error for restarting the same prerelease")
+	}
+}
+
+func TestNewPrereleaseOverflow(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxInt := strconv.Itoa(int(^uint(0) >> 1))
+	_, err = s.NewPrerelease("1."+maxInt+".0", "beta")
+	if overflowErr, ok := errors.Cause(err).(*semver.OverflowError); !ok || overflowErr.Part != "minor" {
+		t.Fatalf("expected an *OverflowError for the minor, got %v", err)
+	}
+}
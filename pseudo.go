@@ -0,0 +1,115 @@
+package semver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+const (
+	pseudoTimestampLayout = "20060102150405"
+	pseudoTimestampLength = len(pseudoTimestampLayout)
+)
+
+var rePseudo = regexp.MustCompile(`^v(0|[1-9]\d*)\.(?:0\.0-|(0|[1-9]\d*)\.(0|[1-9]\d*)-(?:[^+]*\.)?0\.)\d{14}-` +
+	`[0-9a-zA-Z]+(?:\+[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*)?$`)
+
+// PseudoVersion is a Go modules pseudo-version like `v0.0.0-20230101120000-abcdef123456`.
+type PseudoVersion struct {
+	// Base is the version the pseudo-version builds on, without the `v` prefix.
+	// It's empty when the pseudo-version isn't based on any tagged version.
+	Base      string
+	Timestamp time.Time
+	Revision  string
+
+	version *semVersion
+}
+
+// ValidPseudo checks if the given version is a valid Go modules pseudo-version.
+func (s *Semver) ValidPseudo(version string) bool {
+	return rePseudo.MatchString(version) && s.Valid(strings.TrimPrefix(version, "v"))
+}
+
+// ParsePseudo parses the given Go modules pseudo-version.
+func (s *Semver) ParsePseudo(version string) (*PseudoVersion, error) {
+	if !s.ValidPseudo(version) {
+		return nil, errors.Errorf("pseudo-version `%s` is invalid", version)
+	}
+	semVersion, err := s.buildVersion(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	dash := strings.LastIndex(semVersion.tag, "-")
+	pseudo := &PseudoVersion{
+		Revision: semVersion.tag[dash+1:],
+		version:  semVersion,
+	}
+	rest := semVersion.tag[:dash]
+	prefix := rest[:len(rest)-pseudoTimestampLength]
+	pseudo.Timestamp, err = time.Parse(pseudoTimestampLayout, rest[len(prefix):])
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	switch {
+	case prefix == "":
+		// vX.0.0-yyyymmddhhmmss-abcdefabcdef has no base version.
+	case prefix == "0.":
+		// vX.Y.(Z+1)-0.yyyymmddhhmmss-abcdefabcdef is based on vX.Y.Z.
+		if semVersion.revision == 0 {
+			return nil, errors.Errorf("pseudo-version `%s` has no base to decrement from", version)
+		}
+		pseudo.Base = strconv.Itoa(semVersion.major) + "." + strconv.Itoa(semVersion.minor) + "." +
+			strconv.Itoa(semVersion.revision-1)
+	default:
+		// vX.Y.Z-pre.0.yyyymmddhhmmss-abcdefabcdef is based on vX.Y.Z-pre.
+		pseudo.Base = strconv.Itoa(semVersion.major) + "." + strconv.Itoa(semVersion.minor) + "." +
+			strconv.Itoa(semVersion.revision) + "-" + strings.TrimSuffix(prefix, ".0.")
+	}
+	return pseudo, nil
+}
+
+// ComparePseudo compares two Go module versions, which can be either regular `v` prefixed versions
+// or pseudo-versions. Pseudo-versions on the same base are ordered by their timestamp.
+// It returns -1 when a is lower than b, 1 when it's higher and 0 when they're equal.
+func (s *Semver) ComparePseudo(a string, b string) (int, error) {
+	semA, pseudoA, err := s.buildModuleVersion(a)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	semB, pseudoB, err := s.buildModuleVersion(b)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if pseudoA != nil && pseudoB != nil && pseudoA.Base == pseudoB.Base && semA.major == semB.major &&
+		semA.minor == semB.minor && semA.revision == semB.revision {
+		if pseudoA.Timestamp.Before(pseudoB.Timestamp) {
+			return -1, nil
+		}
+		if pseudoA.Timestamp.After(pseudoB.Timestamp) {
+			return 1, nil
+		}
+		return strings.Compare(pseudoA.Revision, pseudoB.Revision), nil
+	}
+	return compareSemVersions(semA, semB), nil
+}
+
+func (s *Semver) buildModuleVersion(version string) (*semVersion, *PseudoVersion, error) {
+	if s.ValidPseudo(version) {
+		pseudo, err := s.ParsePseudo(version)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		return pseudo.version, pseudo, nil
+	}
+	if !strings.HasPrefix(version, "v") || !s.Valid(version[1:]) {
+		return nil, nil, errors.Errorf("module version `%s` is invalid", version)
+	}
+	semVersion, err := s.buildVersion(version[1:])
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return semVersion, nil, nil
+}
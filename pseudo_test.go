@@ -0,0 +1,118 @@
+package semver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var (
+	validPseudoVersions = []struct {
+		version   string
+		base      string
+		timestamp string
+		revision  string
+	}{
+		{"v0.0.0-20230101120000-abcdef123456", "", "20230101120000", "abcdef123456"},
+		{"v1.2.4-0.20230101120000-abcdef123456", "1.2.3", "20230101120000", "abcdef123456"},
+		{"v1.2.3-rc.1.0.20230101120000-abcdef123456", "1.2.3-rc.1", "20230101120000", "abcdef123456"},
+		{"v2.0.0-20230101120000-abcdef123456+incompatible", "", "20230101120000", "abcdef123456"},
+	}
+	invalidPseudoVersions = []string{
+		"0.0.0-20230101120000-abcdef123456",
+		"v1.2.3",
+		"v1.2.3-20230101120000-abcdef123456",
+		"v0.0.0-2023010112-abcdef123456",
+		"v0.0.0-20231301120000-abcdef123456",
+	}
+	comparePseudoVersions = []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"v0.0.0-20230101120000-abcdef123456", "v0.0.0-20230102120000-abcdef123456", -1},
+		{"v0.0.0-20230102120000-abcdef123456", "v0.0.0-20230101120000-abcdef123456", 1},
+		{"v0.0.0-20230101120000-abcdef123456", "v0.0.0-20230101120000-abcdef123456", 0},
+		{"v1.2.4-0.20230101120000-abcdef123456", "v1.2.3", 1},
+		{"v1.2.4-0.20230101120000-abcdef123456", "v1.2.4", -1},
+		{"v1.2.3-rc.1.0.20230101120000-abcdef123456", "v1.2.3-rc.1", 1},
+		{"v1.2.3-rc.1.0.20230101120000-abcdef123456", "v1.2.3-rc.2", -1},
+		{"v1.0.0", "v1.0.1", -1},
+	}
+)
+
+func TestParsePseudo(t *testing.T) {
+	for k := range validPseudoVersions {
+		p := validPseudoVersions[k]
+		t.Run("parse-pseudo-"+p.version, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if !semver.ValidPseudo(p.version) {
+				t2.Fatalf("expecting `%s` to be a valid pseudo-version", p.version)
+			}
+			pseudo, err := semver.ParsePseudo(p.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if pseudo.Base != p.base {
+				t2.Fatalf("expected base `%s`, got `%s`", p.base, pseudo.Base)
+			}
+			if pseudo.Timestamp.Format("20060102150405") != p.timestamp || pseudo.Timestamp.Location() != time.UTC {
+				t2.Fatalf("expected timestamp `%s`, got `%s`", p.timestamp, pseudo.Timestamp)
+			}
+			if pseudo.Revision != p.revision {
+				t2.Fatalf("expected revision `%s`, got `%s`", p.revision, pseudo.Revision)
+			}
+		})
+	}
+}
+
+func TestInvalidPseudo(t *testing.T) {
+	for k := range invalidPseudoVersions {
+		version := invalidPseudoVersions[k]
+		t.Run("invalid-pseudo-"+version, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if _, err := semver.ParsePseudo(version); err == nil {
+				t2.Fatalf("expecting `%s` to be an invalid pseudo-version", version)
+			}
+		})
+	}
+}
+
+func TestComparePseudo(t *testing.T) {
+	for k := range comparePseudoVersions {
+		c := comparePseudoVersions[k]
+		t.Run("compare-pseudo-"+c.a+"_"+c.b, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			result, err := semver.ComparePseudo(c.a, c.b)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if result != c.expected {
+				t2.Fatalf("expected `%s` compared to `%s` to be %d, got %d", c.a, c.b, c.expected, result)
+			}
+		})
+	}
+}
+
+func TestComparePseudoErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.ComparePseudo("1.0.0", "v1.0.0"); err == nil {
+		t.Fatal("expected an error for a version without the `v` prefix")
+	}
+	if _, err := semver.ComparePseudo("v1.0.0", "v1.0"); err == nil {
+		t.Fatal("expected an error for an invalid module version")
+	}
+}
@@ -0,0 +1,35 @@
+package semver
+
+import (
+	"strings"
+)
+
+// WithQuadSegments allows versions with a fourth numeric part, like the `1.2.3.4` of Windows and many
+// enterprise products. The fourth part is parsed as the Revision and compared after the patch, so
+// `1.2.3` equals `1.2.3.0` and is lower than `1.2.3.1`. Versions parsed with four parts keep them
+// in their canonical notation. Three-part versions are still valid. CompareStrings and the core
+// package don't support four parts.
+func WithQuadSegments() Option {
+	return func(s *Semver) error {
+		s.quad = true
+		return nil
+	}
+}
+
+// splitQuad splits the fourth part off the version when quad segments are allowed and the version has
+// four parts. The rest is the version without the fourth part.
+func (s *Semver) splitQuad(version string) (string, string, bool) {
+	if !s.quad {
+		return version, "", false
+	}
+	end := strings.IndexAny(version, "-+")
+	if end < 0 {
+		end = len(version)
+	}
+	core := version[:end]
+	if strings.Count(core, ".") != 3 {
+		return version, "", false
+	}
+	i := strings.LastIndexByte(core, '.')
+	return core[:i] + version[end:], core[i+1:], true
+}
@@ -0,0 +1,145 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestWithQuadSegmentsValid(t *testing.T) {
+	strict := semver.MustNew()
+	quad := semver.MustNew(semver.WithQuadSegments())
+	cases := []struct {
+		version string
+		strict  bool
+		quad    bool
+	}{
+		{"1.2.3", true, true},
+		{"1.2.3.4", false, true},
+		{"10.0.19041.1234-rc.1+build.5", false, true},
+		{"1.2.3.04", false, false},
+		{"1.2.3.4.5", false, false},
+		{"1.2.3.", false, false},
+		{"1.2.3.x", false, false},
+		{"1.2.3-rc.1.2", true, true},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version, func(t2 *testing.T) {
+			if result := strict.Valid(c.version); result != c.strict {
+				t2.Fatalf("expected strict validity to be %v, got %v", c.strict, result)
+			}
+			if result := quad.Valid(c.version); result != c.quad {
+				t2.Fatalf("expected quad validity to be %v, got %v", c.quad, result)
+			}
+		})
+	}
+}
+
+func TestWithQuadSegmentsParse(t *testing.T) {
+	s := semver.MustNew(semver.WithQuadSegments())
+	v := s.MustParse("10.0.19041.1234-rc.1+build.5")
+	if v.Major != 10 || v.Patch != 19041 || v.Revision != 1234 || v.Prerelease != "rc.1" || v.Build != "build.5" {
+		t.Fatalf("unexpected parsed version %+v", *v)
+	}
+	if result := v.String(); result != "10.0.19041.1234-rc.1+build.5" {
+		t.Fatalf("expected the canonical notation to keep four parts, got `%s`", result)
+	}
+	if result := s.MustParse("1.2.3.0").String(); result != "1.2.3.0" {
+		t.Fatalf("expected `1.2.3.0`, got `%s`", result)
+	}
+	if result := s.MustParse("1.2.3").String(); result != "1.2.3" {
+		t.Fatalf("expected `1.2.3`, got `%s`", result)
+	}
+	_, err := s.Parse("1.2.3.99999999999999999999")
+	if overflow, ok := errors.Cause(err).(*semver.OverflowError); !ok || overflow.Part != "revision" {
+		t.Fatalf("expected a revision *OverflowError as cause, got %v", err)
+	}
+	_, err = s.Parse("1.2.3.04")
+	if leadingZero, ok := errors.Cause(err).(*semver.LeadingZeroError); !ok || leadingZero.Part != "revision" {
+		t.Fatalf("expected a revision *LeadingZeroError as cause, got %v", err)
+	}
+}
+
+func TestWithQuadSegmentsCompare(t *testing.T) {
+	s := semver.MustNew(semver.WithQuadSegments())
+	cases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1.2.3.4", "1.2.3.5", -1},
+		{"1.2.3", "1.2.3.0", 0},
+		{"1.2.3", "1.2.3.1", -1},
+		{"1.2.4", "1.2.3.99", 1},
+		{"1.2.3.1-rc.1", "1.2.3.1", -1},
+	}
+	for k := range cases {
+		c := cases[k]
+		result, err := s.Compare(c.a, c.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result != c.expected {
+			t.Fatalf("expected comparing `%s` to `%s` to be %d, got %d", c.a, c.b, c.expected, result)
+		}
+	}
+	if s.MustParse("1.2.3").Hash() != s.MustParse("1.2.3.0").Hash() {
+		t.Fatal("expected equal versions to have equal hashes")
+	}
+	constraint, err := s.ParseConstraint("~1.2.3.4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for version, expected := range map[string]bool{"1.2.3.3": false, "1.2.3.4": true, "1.2.9.0": true, "1.3.0.0": false} {
+		if result := constraint.CheckVersion(s.MustParse(version)); result != expected {
+			t.Fatalf("expected `%s` to satisfy `~1.2.3.4` to be %v, got %v", version, expected, result)
+		}
+	}
+	next, err := s.BumpFromDiff("1.2.3.4", []string{"patch"})
+	if err != nil || next != "1.2.4.0" {
+		t.Fatalf("expected `1.2.4.0`, got `%s` (%v)", next, err)
+	}
+}
+
+func TestWithQuadSegmentsRange(t *testing.T) {
+	s := semver.MustNew(semver.WithQuadSegments())
+	greater, err := s.GreaterThanOrEqual("1.2.3.4", "1.2.3.5")
+	if err != nil || greater {
+		t.Fatalf("expected `1.2.3.4` not to be greater than `1.2.3.5`, got %v, %v", greater, err)
+	}
+	smaller, err := s.SmallerThanOrEqual("1.2.3.4", "1.2.3.5")
+	if err != nil || !smaller {
+		t.Fatalf("expected `1.2.3.4` to be smaller than `1.2.3.5`, got %v, %v", smaller, err)
+	}
+	cases := []struct {
+		version  string
+		start    string
+		end      string
+		expected bool
+	}{
+		{"1.2.3.4", "1.2.3.1", "1.2.3.9", true},
+		{"1.2.3.4", "1.2.3.5", semver.Unbounded, false},
+		{"1.2.3.4", semver.Unbounded, "1.2.3.3", false},
+		{"1.2.3", "1.2.3.0", "1.2.3.0", true},
+	}
+	for k := range cases {
+		c := cases[k]
+		inRange, err := s.InRange(c.version, c.start, c.end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inRange != c.expected {
+			t.Fatalf("expected `%s` in `%s` to `%s` to be %v, got %v", c.version, c.start, c.end, c.expected, inRange)
+		}
+	}
+	decoded := semver.NewVersionText(s)
+	if err := decoded.UnmarshalText([]byte("1.2.3.4")); err != nil || decoded.Version.Revision != 4 {
+		t.Fatalf("expected `1.2.3.4` to decode, got %+v, %v", decoded.Version, err)
+	}
+	var plain semver.Version
+	if err := plain.UnmarshalText([]byte("1.2.3.4")); err == nil {
+		t.Fatal("expected `1.2.3.4` not to decode without quad segments")
+	}
+}
@@ -0,0 +1,90 @@
+package semver
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+)
+
+// Range is an inclusive range of versions like InRange takes. Either bound can be Unbounded.
+type Range struct {
+	Start string
+	End   string
+}
+
+// InAnyRange checks the version against the ranges like InRange does and returns the index of the
+// first range it's in. The index is -1 when it's in none of them.
+func (s *Semver) InAnyRange(version string, ranges []Range) (int, bool, error) {
+	if !s.Valid(version) {
+		return -1, false, errors.Errorf("version `%s` is invalid", version)
+	}
+	for k := range ranges {
+		in, err := s.InRange(version, ranges[k].Start, ranges[k].End)
+		if err != nil {
+			return -1, false, errors.Annotatef(err, "range %d", k)
+		}
+		if in {
+			return k, true, nil
+		}
+	}
+	return -1, false, nil
+}
+
+// Enumerate returns the versions of the universe that are in the range like InRange checks, from
+// lowest to highest. Versions that aren't valid are skipped, as is everything when the range
+// itself is invalid.
+func (s *Semver) Enumerate(r Range, universe []string) []string {
+	var versions []*Version
+	for k := range universe {
+		in, err := s.InRange(universe[k], r.Start, r.End)
+		if err != nil || !in {
+			continue
+		}
+		v, err := s.Parse(universe[k])
+		if err != nil {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Compare(versions[j]) < 0
+	})
+	enumerated := make([]string, len(versions))
+	for k := range versions {
+		enumerated[k] = versions[k].Original()
+	}
+	return enumerated
+}
+
+// Patches generates every patch release in the range, like `1.2.0` through `1.2.10`, for test matrices.
+// Both bounds have to be set and be of the same epoch, major and minor, and at most limit versions are
+// generated. An end with a prerelease like `1.2.5-rc.1` is below its release, so `1.2.4` is the last patch.
+func (s *Semver) Patches(r Range, limit int) ([]string, error) {
+	if r.Start == Unbounded || r.End == Unbounded {
+		return nil, errors.New("range should have both bounds to generate patches")
+	}
+	start, err := s.Parse(r.Start)
+	if err != nil {
+		return nil, errors.Annotate(err, "start")
+	}
+	end, err := s.Parse(r.End)
+	if err != nil {
+		return nil, errors.Annotate(err, "end")
+	}
+	if start.Epoch != end.Epoch || start.Major != end.Major || start.Minor != end.Minor {
+		return nil, errors.Errorf("range `%s` to `%s` should be within one minor to generate patches", r.Start, r.End)
+	}
+	last := end.Patch
+	if end.Prerelease != "" {
+		last--
+	}
+	if last-start.Patch >= limit {
+		return nil, errors.Errorf("range `%s` to `%s` has more than %d patches", r.Start, r.End, limit)
+	}
+	var patches []string
+	for patch := start.Patch; patch <= last; patch++ {
+		v := &Version{Epoch: start.Epoch, Major: start.Major, Minor: start.Minor, Patch: patch}
+		patches = append(patches, v.canonical())
+	}
+	return patches, nil
+}
@@ -0,0 +1,126 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestInAnyRange(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranges := []semver.Range{
+		{Start: semver.Unbounded, End: "1.9.9"},
+		{Start: "2.0.0", End: "2.4.0"},
+		{Start: "2.2.0", End: semver.Unbounded},
+	}
+	cases := []struct {
+		version  string
+		index    int
+		expected bool
+	}{
+		{"1.2.3", 0, true},
+		{"2.0.0", 1, true},
+		{"2.3.0", 1, true},
+		{"2.5.0", 2, true},
+		{"1.9.10", -1, false},
+	}
+	for k := range cases {
+		c := cases[k]
+		index, ok, err := s.InAnyRange(c.version, ranges)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if index != c.index || ok != c.expected {
+			t.Fatalf("expected `%s` to be in range %d (%v), got %d (%v)", c.version, c.index, c.expected, index, ok)
+		}
+	}
+}
+
+func TestInAnyRangeErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.InAnyRange("1.2", []semver.Range{{Start: "1.0.0", End: "2.0.0"}}); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, _, err := s.InAnyRange("1.2.0", nil); err != nil {
+		t.Fatalf("expected no error without ranges, got %v", err)
+	}
+	_, _, err = s.InAnyRange("1.2.0", []semver.Range{{Start: "2.0.0", End: "3.0.0"}, {Start: "1.0", End: "2.0.0"}})
+	if err == nil {
+		t.Fatal("expected an error for an invalid range")
+	}
+}
+
+func TestRangeEnumerate(t *testing.T) {
+	universe := []string{"2.0.0", "1.2.10", "1.2.0", "1.2", "1.3.0-rc.1", "0.9.0", "1.2.2+build"}
+	cases := []struct {
+		r        semver.Range
+		expected []string
+	}{
+		{semver.Range{Start: "1.2.0", End: "1.9.0"}, []string{"1.2.0", "1.2.2+build", "1.2.10", "1.3.0-rc.1"}},
+		{semver.Range{Start: semver.Unbounded, End: "1.2.0"}, []string{"0.9.0", "1.2.0"}},
+		{semver.Range{Start: "3.0.0", End: semver.Unbounded}, []string{}},
+		{semver.Range{Start: "1.0", End: semver.Unbounded}, []string{}},
+	}
+	s := semver.MustNew()
+	for k := range cases {
+		c := cases[k]
+		result := s.Enumerate(c.r, universe)
+		if strings.Join(result, ",") != strings.Join(c.expected, ",") {
+			t.Fatalf("expected %v for %+v, got %v", c.expected, c.r, result)
+		}
+	}
+	epoch := semver.MustNew(semver.WithEpoch())
+	result := epoch.Enumerate(semver.Range{Start: "1:1.0.0", End: semver.Unbounded}, []string{"1:1.2.0", "2.0.0", "2:0.1.0"})
+	if strings.Join(result, ",") != "1:1.2.0,2:0.1.0" {
+		t.Fatalf("expected the epochs to be enumerated, got %v", result)
+	}
+}
+
+func TestRangePatches(t *testing.T) {
+	s := semver.MustNew()
+	patches, err := s.Patches(semver.Range{Start: "1.2.0", End: "1.2.10"}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(patches) != 11 || patches[0] != "1.2.0" || patches[10] != "1.2.10" {
+		t.Fatalf("expected 1.2.0 through 1.2.10, got %v", patches)
+	}
+	patches, err = s.Patches(semver.Range{Start: "1.2.0", End: "1.2.5-rc.1"}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(patches, " ") != "1.2.0 1.2.1 1.2.2 1.2.3 1.2.4" {
+		t.Fatalf("expected 1.2.0 through 1.2.4, got %v", patches)
+	}
+	patches, err = semver.MustNew(semver.WithEpoch()).Patches(semver.Range{Start: "1:1.2.0", End: "1:1.2.1"}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(patches, " ") != "1:1.2.0 1:1.2.1" {
+		t.Fatalf("expected the epoch to be kept, got %v", patches)
+	}
+	cases := []struct {
+		name string
+		r    semver.Range
+	}{
+		{"unbounded", semver.Range{Start: "1.2.0", End: semver.Unbounded}},
+		{"invalid", semver.Range{Start: "1.2", End: "1.2.3"}},
+		{"across minors", semver.Range{Start: "1.2.0", End: "1.3.0"}},
+		{"over limit", semver.Range{Start: "1.2.0", End: "1.2.100"}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			if _, err := s.Patches(c.r, 100); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+}
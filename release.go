@@ -0,0 +1,199 @@
+package semver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Bump is the magnitude of a version increment.
+type Bump int
+
+// The bump magnitudes from lowest to highest.
+const (
+	BumpNone Bump = iota
+	BumpPatch
+	BumpMinor
+	BumpMajor
+)
+
+var (
+	bumpNames             = []string{"none", "patch", "minor", "major"}
+	reConventionalSubject = regexp.MustCompile(`^([a-zA-Z]+)(?:\([^)]*\))?(!)?: `)
+)
+
+func (b Bump) String() string {
+	if b < BumpNone || b > BumpMajor {
+		return "unknown"
+	}
+	return bumpNames[b]
+}
+
+// ConventionalBump classifies a commit message by the conventional commits spec. Breaking changes
+// are a major bump, `feat` commits a minor one and `fix` or `perf` commits a patch. Anything else,
+// including messages that don't follow the spec, doesn't require a bump.
+func ConventionalBump(message string) Bump {
+	subject := strings.SplitN(message, "\n", 2)[0]
+	matches := reConventionalSubject.FindStringSubmatch(subject)
+	if matches != nil && matches[2] == "!" {
+		return BumpMajor
+	}
+	if strings.Contains(message, "\nBREAKING CHANGE: ") || strings.Contains(message, "\nBREAKING-CHANGE: ") {
+		return BumpMajor
+	}
+	if matches == nil {
+		return BumpNone
+	}
+	switch strings.ToLower(matches[1]) {
+	case "feat":
+		return BumpMinor
+	case "fix", "perf":
+		return BumpPatch
+	}
+	return BumpNone
+}
+
+// ReleaseCandidate describes a proposed release for ValidateRelease.
+type ReleaseCandidate struct {
+	// Previous is the latest released version. It's empty for the first release.
+	Previous string
+	Proposed string
+	// ChangelogVersions are the versions the changelog has a section for.
+	ChangelogVersions []string
+	// Changes are the bumps the changes since Previous require, like from ConventionalBump.
+	Changes []Bump
+}
+
+// ReleaseReport is the outcome of ValidateRelease. Failures describes every check that didn't pass.
+type ReleaseReport struct {
+	Pass     bool
+	Required Bump
+	Actual   Bump
+	Failures []string
+}
+
+// ValidateRelease cross-checks a proposed release against its changelog and changes. The proposed
+// version has to be in the changelog, be higher than the previous version and bump it by exactly
+// the magnitude the changes require. Before 1.0.0 breaking changes only require a minor bump.
+// Magnitudes aren't checked when releasing from a prerelease, as promoting it needs no bump.
+func (s *Semver) ValidateRelease(candidate ReleaseCandidate) (*ReleaseReport, error) {
+	proposed, err := s.Parse(candidate.Proposed)
+	if err != nil {
+		return nil, errors.Annotate(err, "proposed")
+	}
+	report := &ReleaseReport{}
+	for k := range candidate.Changes {
+		if candidate.Changes[k] > report.Required {
+			report.Required = candidate.Changes[k]
+		}
+	}
+
+	var inChangelog bool
+	for k := range candidate.ChangelogVersions {
+		v, err := s.Parse(candidate.ChangelogVersions[k])
+		if err != nil {
+			return nil, errors.Annotate(err, "changelog")
+		}
+		if v.Compare(proposed) == 0 {
+			inChangelog = true
+		}
+	}
+	if !inChangelog {
+		report.Failures = append(report.Failures, "the changelog has no section for `"+candidate.Proposed+"`")
+	}
+
+	if candidate.Previous != "" {
+		previous, err := s.Parse(candidate.Previous)
+		if err != nil {
+			return nil, errors.Annotate(err, "previous")
+		}
+		report.Actual = bumpBetween(previous, proposed)
+		if proposed.Compare(previous) <= 0 {
+			report.Failures = append(report.Failures, "`"+candidate.Proposed+"` is not higher than `"+
+				candidate.Previous+"`")
+		} else if previous.Prerelease == "" {
+			report.Failures = append(report.Failures, checkBump(previous, report.Required, report.Actual)...)
+		}
+	}
+	report.Pass = len(report.Failures) == 0
+	return report, nil
+}
+
+// checkBump checks that the actual bump is the one the changes require. A 1.0.0 release may
+// follow breaking changes before 1.0.0, which otherwise only require a minor bump.
+func checkBump(previous *Version, required Bump, actual Bump) []string {
+	if required == BumpNone {
+		return []string{"there are no changes that require a release"}
+	}
+	var allowMajor bool
+	if previous.Major == 0 && required == BumpMajor {
+		required = BumpMinor
+		allowMajor = true
+	}
+	if actual < required {
+		return []string{"the changes require a " + required.String() + " bump, but it's a " + actual.String() + " bump"}
+	}
+	if actual > required && !(allowMajor && actual == BumpMajor) {
+		return []string{"it's a " + actual.String() + " bump, but the changes only require a " + required.String() +
+			" bump"}
+	}
+	return nil
+}
+
+// bumpBetween returns the highest part that changed between both versions.
+func bumpBetween(a *Version, b *Version) Bump {
+	switch {
+	case a.Major != b.Major:
+		return BumpMajor
+	case a.Minor != b.Minor:
+		return BumpMinor
+	case a.Patch != b.Patch:
+		return BumpPatch
+	}
+	return BumpNone
+}
+
+// Bump returns the next version for the bump, so a minor bump of `1.2.3-rc.1` is `1.3.0`. The prerelease
+// and build metadata are dropped. A part that would overflow or exceed the max segment fails with an
+// *OverflowError as cause.
+func (v *Version) Bump(bump Bump) (*Version, error) {
+	next, err := v.increment(bump)
+	return next, errors.Trace(err)
+}
+
+// increment returns the version with the part of the bump incremented and the lower parts reset,
+// without prerelease and build metadata. A part that would overflow, or exceed the max segment of
+// WithMaxSegment, fails with an *OverflowError as cause.
+func (v *Version) increment(bump Bump) (*Version, error) {
+	next := v.core()
+	var part string
+	var value int
+	switch bump {
+	case BumpNone:
+		return next, nil
+	case BumpPatch:
+		part, value = "patch", v.Patch
+		next.Patch++
+		next.Revision = 0
+	case BumpMinor:
+		part, value = "minor", v.Minor
+		next.Minor++
+		next.Patch = 0
+		next.Revision = 0
+	case BumpMajor:
+		part, value = "major", v.Major
+		next.Major++
+		next.Minor = 0
+		next.Patch = 0
+		next.Revision = 0
+	default:
+		return nil, errors.Errorf("bump `%d` is invalid", bump)
+	}
+	if value >= v.segmentLimit() {
+		return nil, errors.Trace(&OverflowError{Version: v.Original(), Part: part,
+			Value: strconv.FormatUint(uint64(value)+1, 10)})
+	}
+	return next, nil
+}
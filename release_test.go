@@ -0,0 +1,105 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestConventionalBump(t *testing.T) {
+	cases := map[string]semver.Bump{
+		"feat: add a thing":                        semver.BumpMinor,
+		"feat(parser): add a thing":                semver.BumpMinor,
+		"fix: repair a thing":                      semver.BumpPatch,
+		"perf(compare): speed up":                  semver.BumpPatch,
+		"refactor!: drop the old API":              semver.BumpMajor,
+		"feat: x\n\nBREAKING CHANGE: removes y":    semver.BumpMajor,
+		"docs: update the readme":                  semver.BumpNone,
+		"Update the readme":                        semver.BumpNone,
+		"chore(deps): bump github.com/juju/errors": semver.BumpNone,
+	}
+	for message, expected := range cases {
+		if bump := semver.ConventionalBump(message); bump != expected {
+			t.Fatalf("expected `%s` to be a %s bump, got %s", message, expected, bump)
+		}
+	}
+}
+
+func TestValidateRelease(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	changelog := []string{"2.0.0", "1.3.0", "1.2.1", "1.2.0", "0.5.0", "0.4.0"}
+	cases := []struct {
+		name     string
+		previous string
+		proposed string
+		changes  []semver.Bump
+		failures []string
+	}{
+		{"minor", "1.2.1", "1.3.0", []semver.Bump{semver.BumpPatch, semver.BumpMinor}, nil},
+		{"patch", "1.2.0", "1.2.1", []semver.Bump{semver.BumpPatch, semver.BumpNone}, nil},
+		{"major", "1.3.0", "2.0.0", []semver.Bump{semver.BumpMajor}, nil},
+		{"first", "", "1.2.0", nil, nil},
+		{"breaking-before-1", "0.4.0", "0.5.0", []semver.Bump{semver.BumpMajor}, nil},
+		{"missing-changelog", "1.3.0", "1.3.1", []semver.Bump{semver.BumpPatch}, []string{"no section"}},
+		{"too-small", "1.2.1", "1.2.2", []semver.Bump{semver.BumpMinor}, []string{"no section", "require a minor"}},
+		{"too-big", "1.2.1", "2.0.0", []semver.Bump{semver.BumpPatch}, []string{"only require a patch"}},
+		{"no-changes", "1.2.1", "1.3.0", []semver.Bump{semver.BumpNone}, []string{"no changes"}},
+		{"backwards", "2.0.0", "1.3.0", []semver.Bump{semver.BumpMinor}, []string{"not higher"}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			report, err := s.ValidateRelease(semver.ReleaseCandidate{
+				Previous:          c.previous,
+				Proposed:          c.proposed,
+				ChangelogVersions: changelog,
+				Changes:           c.changes,
+			})
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if report.Pass != (len(c.failures) == 0) || len(report.Failures) != len(c.failures) {
+				t2.Fatalf("expected failures %v, got %v", c.failures, report.Failures)
+			}
+			for i := range c.failures {
+				if !strings.Contains(report.Failures[i], c.failures[i]) {
+					t2.Fatalf("expected failure `%s` to mention `%s`", report.Failures[i], c.failures[i])
+				}
+			}
+		})
+	}
+	if _, err := s.ValidateRelease(semver.ReleaseCandidate{Proposed: invalidVersions[0]}); err == nil {
+		t.Fatal("expected an error for an invalid proposed version")
+	}
+}
+
+func TestVersionBump(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		version  string
+		bump     semver.Bump
+		expected string
+	}{
+		{"1.2.3-rc.1+build", semver.BumpNone, "1.2.3"},
+		{"1.2.3", semver.BumpPatch, "1.2.4"},
+		{"1.2.3-rc.1", semver.BumpMinor, "1.3.0"},
+		{"1.2.3", semver.BumpMajor, "2.0.0"},
+	}
+	for k := range cases {
+		c := cases[k]
+		next, err := s.MustParse(c.version).Bump(c.bump)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := next.String(); result != c.expected {
+			t.Fatalf("expected a %s bump of `%s` to be `%s`, got `%s`", c.bump, c.version, c.expected, result)
+		}
+	}
+	if _, err := s.MustParse("1.2.3").Bump(semver.Bump(9)); err == nil {
+		t.Fatal("expected an error for an invalid bump")
+	}
+}
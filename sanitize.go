@@ -0,0 +1,50 @@
+package semver
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/juju/errors"
+)
+
+// SanitizeOptions configures Sanitize.
+type SanitizeOptions struct {
+	// ConvertFullWidth converts the full-width forms of ASCII, U+FF01 to U+FF5E, to ASCII instead of
+	// stripping them. That converts all of them, like `１`, `．` and `ｒｃ`, so the clean string can
+	// have characters a version can't have, which validating it rejects.
+	ConvertFullWidth bool
+}
+
+// Sanitize strips byte order marks, zero-width characters, invalid UTF-8 and the full-width forms
+// of ASCII from the input, like mobile keyboards and encodings tend to add. When anything was
+// stripped or converted, the clean string is returned along with a *SanitizeError describing the
+// changes, so callers can decide whether to accept it. The clean string still has to be validated.
+func Sanitize(input string, options SanitizeOptions) (string, error) {
+	report := &SanitizeError{Input: input}
+	var b strings.Builder
+	b.Grow(len(input))
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		i += size
+		switch {
+		case r == utf8.RuneError && size <= 1:
+			report.InvalidBytes++
+		case r == '\uFEFF':
+			report.ByteOrderMarks++
+		case r == '\u200B' || r == '\u200C' || r == '\u200D' || r == '\u2060':
+			report.ZeroWidth++
+		case r >= '\uFF01' && r <= '\uFF5E':
+			report.FullWidth++
+			if options.ConvertFullWidth {
+				b.WriteRune(r - '\uFF01' + '!')
+				report.Converted = true
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if report.InvalidBytes+report.ByteOrderMarks+report.ZeroWidth+report.FullWidth == 0 {
+		return input, nil
+	}
+	return b.String(), errors.Trace(report)
+}
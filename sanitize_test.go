@@ -0,0 +1,60 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestSanitize(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		options  semver.SanitizeOptions
+		expected string
+		report   *semver.SanitizeError
+	}{
+		{"clean", "1.2.3-rc.1", semver.SanitizeOptions{}, "1.2.3-rc.1", nil},
+		{"bom", "\uFEFF1.2.3", semver.SanitizeOptions{}, "1.2.3", &semver.SanitizeError{ByteOrderMarks: 1}},
+		{"zero-width", "1.\u200B2.3\u200D", semver.SanitizeOptions{}, "1.2.3", &semver.SanitizeError{ZeroWidth: 2}},
+		{"invalid utf-8", "1.2.3\xff", semver.SanitizeOptions{}, "1.2.3", &semver.SanitizeError{InvalidBytes: 1}},
+		{"full-width stripped", "1.2.３", semver.SanitizeOptions{}, "1.2.", &semver.SanitizeError{FullWidth: 1}},
+		{
+			"full-width converted", "１．２．３－ｒｃ", semver.SanitizeOptions{ConvertFullWidth: true}, "1.2.3-rc",
+			&semver.SanitizeError{FullWidth: 8, Converted: true},
+		},
+		{"unicode kept", "1.2.3-é", semver.SanitizeOptions{}, "1.2.3-é", nil},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			result, err := semver.Sanitize(c.input, c.options)
+			if result != c.expected {
+				t2.Fatalf("expected %q, got %q", c.expected, result)
+			}
+			if c.report == nil {
+				if err != nil {
+					t2.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			report, ok := errors.Cause(err).(*semver.SanitizeError)
+			if !ok {
+				t2.Fatalf("expected a *SanitizeError as cause, got %v", err)
+			}
+			c.report.Input = c.input
+			if *report != *c.report {
+				t2.Fatalf("expected %+v, got %+v", *c.report, *report)
+			}
+		})
+	}
+}
+
+func TestSanitizeErrorMessage(t *testing.T) {
+	_, err := semver.Sanitize("\uFEFF１.2.3\u200B", semver.SanitizeOptions{ConvertFullWidth: true})
+	expected := `input "\ufeff１.2.3\u200b" had 1 byte order marks, 1 zero-width characters, 1 full-width characters (converted)`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected %q, got %v", expected, err)
+	}
+}
@@ -0,0 +1,119 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// Scheme is a versioning scheme to validate and compare versions by.
+type Scheme int
+
+// The supported versioning schemes.
+const (
+	SchemeSemver Scheme = iota
+	// SchemePEP440 compares Python package versions, like `1.0rc1` or `2!1.0.post2`.
+	SchemePEP440
+	// SchemeDebian compares Debian package versions the way dpkg does, like `1:2.30-1ubuntu2`.
+	SchemeDebian
+	// SchemeMaven compares Maven artifact versions, like `1.0-alpha-1` or `2.3-SNAPSHOT`.
+	SchemeMaven
+	// SchemeCalVer compares calendar versions, like `2024.06` or `2024.06.2`.
+	SchemeCalVer
+	// SchemeAuto detects whether versions are semver or calendar versions and compares them together.
+	SchemeAuto
+)
+
+var _ Versioning = &schemeVersioning{}
+
+// schemeVersioning implements Versioning on top of the validate and compare functions of a scheme.
+type schemeVersioning struct {
+	name    string
+	valid   func(version string) bool
+	compare func(a string, b string) int
+}
+
+// NewWithScheme returns a Versioning that validates and compares versions by the given scheme.
+func NewWithScheme(scheme Scheme) (Versioning, error) {
+	if scheme == SchemeSemver {
+		s, err := New()
+		return s, errors.Trace(err)
+	}
+	versioning, err := schemeFor(scheme)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return versioning, nil
+}
+
+// schemeFor returns the Versioning of a scheme other than SchemeSemver.
+func schemeFor(scheme Scheme) (*schemeVersioning, error) {
+	switch scheme {
+	case SchemePEP440:
+		return &schemeVersioning{name: "PEP 440", valid: validPEP440, compare: comparePEP440}, nil
+	case SchemeDebian:
+		return &schemeVersioning{name: "Debian", valid: validDebian, compare: compareDebian}, nil
+	case SchemeMaven:
+		return &schemeVersioning{name: "Maven", valid: validMaven, compare: compareMaven}, nil
+	case SchemeCalVer:
+		return &schemeVersioning{name: "CalVer", valid: validCalVer, compare: compareCalVer}, nil
+	case SchemeAuto:
+		return &schemeVersioning{name: "semver or CalVer", valid: validAuto, compare: compareAuto}, nil
+	}
+	return nil, errors.NotSupportedf("scheme %d", scheme)
+}
+
+// Valid checks if the given version is valid by the scheme.
+func (s *schemeVersioning) Valid(version string) bool {
+	return s.valid(version)
+}
+
+// InRange checks if the version is between the given start and end versions, inclusive.
+// Either bound can be Unbounded to leave that side of the range open.
+func (s *schemeVersioning) InRange(version string, start string, end string) (bool, error) {
+	if !s.valid(version) {
+		return false, errors.Errorf("version `%s` is invalid", version)
+	}
+	var err error
+	greaterThanOrEqual := true
+	if start != Unbounded {
+		greaterThanOrEqual, err = s.GreaterThanOrEqual(version, start)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+	}
+	smallerThanOrEqual := true
+	if end != Unbounded {
+		smallerThanOrEqual, err = s.SmallerThanOrEqual(version, end)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+	}
+	return greaterThanOrEqual && smallerThanOrEqual, nil
+}
+
+// GreaterThanOrEqual checks if the given version is greater than or equal to the compare version.
+func (s *schemeVersioning) GreaterThanOrEqual(version string, compare string) (bool, error) {
+	c, err := s.compareValid(version, compare)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return c >= 0, nil
+}
+
+// SmallerThanOrEqual checks if the given version is smaller than or equal to the compare version.
+func (s *schemeVersioning) SmallerThanOrEqual(version string, compare string) (bool, error) {
+	c, err := s.compareValid(version, compare)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return c <= 0, nil
+}
+
+func (s *schemeVersioning) compareValid(version string, compare string) (int, error) {
+	if !s.valid(version) {
+		return 0, errors.Errorf("%s version `%s` is invalid", s.name, version)
+	}
+	if !s.valid(compare) {
+		return 0, errors.Errorf("%s compare `%s` is invalid", s.name, compare)
+	}
+	return s.compare(version, compare), nil
+}
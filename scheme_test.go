@@ -0,0 +1,150 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+// assertOrdered checks that every version is lower than the next one, or equal when listed together.
+func assertOrdered(t *testing.T, versioning semver.Versioning, ordered [][]string) {
+	for k := range ordered {
+		for i := range ordered[k] {
+			if !versioning.Valid(ordered[k][i]) {
+				t.Fatalf("expecting `%s` to be valid", ordered[k][i])
+			}
+			equal := ordered[k][0]
+			if ok, err := versioning.InRange(ordered[k][i], equal, equal); err != nil || !ok {
+				t.Fatalf("expected `%s` to equal `%s`: %v", ordered[k][i], equal, err)
+			}
+		}
+		if k == 0 {
+			continue
+		}
+		lower, higher := ordered[k-1][0], ordered[k][0]
+		smaller, err := versioning.SmallerThanOrEqual(higher, lower)
+		if err != nil {
+			t.Fatal(err)
+		}
+		greater, err := versioning.GreaterThanOrEqual(lower, higher)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if smaller || greater {
+			t.Fatalf("expected `%s` to be lower than `%s`", lower, higher)
+		}
+	}
+}
+
+func TestSchemePEP440(t *testing.T) {
+	versioning, err := semver.NewWithScheme(semver.SchemePEP440)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOrdered(t, versioning, [][]string{
+		{"1.0.dev456"},
+		{"1.0a1", "1.0alpha1", "1.0-a.1"},
+		{"1.0a2.dev456"},
+		{"1.0a12"},
+		{"1.0b1.dev456"},
+		{"1.0b2", "1.0beta2"},
+		{"1.0b2.post345"},
+		{"1.0rc1", "1.0c1", "1.0pre1"},
+		{"1.0", "1.0.0", "v1.0", "1"},
+		{"1.0+abc.5"},
+		{"1.0+abc.7"},
+		{"1.0+5"},
+		{"1.0.post456.dev34"},
+		{"1.0.post456", "1.0-456", "1.0.r456"},
+		{"1.1.dev1"},
+		{"1.10"},
+		{"1!0.1"},
+	})
+	for _, version := range []string{"", "1.0-foo", "a.b", "1.0+", "1!"} {
+		if versioning.Valid(version) {
+			t.Fatalf("expecting `%s` to be invalid", version)
+		}
+	}
+}
+
+func TestSchemeDebian(t *testing.T) {
+	versioning, err := semver.NewWithScheme(semver.SchemeDebian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOrdered(t, versioning, [][]string{
+		{"1.0~rc1"},
+		{"1.0", "0:1.0", "1.0-0", "1.00"},
+		{"1.0-1"},
+		{"1.0-1ubuntu1"},
+		{"1.0-2"},
+		{"1.0a"},
+		{"1.0+dfsg"},
+		{"1.0.1"},
+		{"1.2"},
+		{"1.10"},
+		{"1:0.9"},
+		{"2:1.0~beta1:1-1"},
+	})
+	for _, version := range []string{"", "a1.0", "1.0-", "x:1.0", "1.0:1", "1.0-a_b"} {
+		if versioning.Valid(version) {
+			t.Fatalf("expecting `%s` to be invalid", version)
+		}
+	}
+}
+
+func TestSchemeMaven(t *testing.T) {
+	versioning, err := semver.NewWithScheme(semver.SchemeMaven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOrdered(t, versioning, [][]string{
+		{"1.0-alpha-1", "1.0-a1", "1.0-ALPHA-1"},
+		{"1.0-beta"},
+		{"1.0-milestone-1", "1.0-m1"},
+		{"1.0-rc1", "1.0-cr1"},
+		{"1.0-SNAPSHOT"},
+		{"1.0", "1", "1.0.0", "1.0-final", "1.0-ga"},
+		{"1.0-sp1"},
+		{"1.0-xyz"},
+		{"1.0.1"},
+		{"1.1"},
+		{"1.10"},
+		{"2.0"},
+	})
+	for _, version := range []string{"", "alpha", "1.0 beta", "1.0/2"} {
+		if versioning.Valid(version) {
+			t.Fatalf("expecting `%s` to be invalid", version)
+		}
+	}
+}
+
+func TestNewWithScheme(t *testing.T) {
+	versioning, err := semver.NewWithScheme(semver.SchemeSemver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := versioning.(*semver.Semver); !ok {
+		t.Fatal("expected the semver scheme to return a *Semver")
+	}
+	if _, err := semver.NewWithScheme(semver.Scheme(99)); !errors.IsNotSupported(err) {
+		t.Fatalf("expected a not supported error, got %v", err)
+	}
+	versioning, err = semver.NewWithScheme(semver.SchemeDebian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := versioning.InRange("a", "1.0", ""); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if ok, err := versioning.InRange("5.0", "1.0", ""); err != nil || !ok {
+		t.Fatalf("expected an empty end to be unbounded: %v", err)
+	}
+	if ok, err := versioning.GreaterThanOrEqual("a", "1.0"); err == nil || ok {
+		t.Fatalf("expected false and an error for an invalid version, got %t, %v", ok, err)
+	}
+	if ok, err := versioning.SmallerThanOrEqual("1.0", "a"); err == nil || ok {
+		t.Fatalf("expected false and an error for an invalid compare, got %t, %v", ok, err)
+	}
+}
@@ -0,0 +1,44 @@
+package semver
+
+import (
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// WithMaxSegment caps the numeric parts of versions at max, for downstream systems that store them in
+// smaller integers, like 65535 for a uint16. Versions with a bigger part are invalid and fail to parse,
+// and bumps past the cap fail, both with an *OverflowError as cause. By default a part only has to fit
+// in an int.
+func WithMaxSegment(max int) Option {
+	return func(s *Semver) error {
+		if max <= 0 {
+			return errors.Errorf("max segment %d should be positive", max)
+		}
+		s.maxSegment = max
+		return nil
+	}
+}
+
+// checkMaxSegment returns an *OverflowError for the first part of the version that's above the max segment.
+func (s *Semver) checkMaxSegment(version string, v *semVersion) error {
+	if s.maxSegment == 0 {
+		return nil
+	}
+	parts := []int{v.epoch, v.major, v.minor, v.revision, v.fourth}
+	names := []string{"epoch", "major", "minor", "patch", "revision"}
+	for k := range parts {
+		if parts[k] > s.maxSegment {
+			return errors.Trace(&OverflowError{Version: version, Part: names[k], Value: strconv.Itoa(parts[k])})
+		}
+	}
+	return nil
+}
+
+// segmentLimit is the highest value a part of the version can be bumped to.
+func (v *Version) segmentLimit() int {
+	if v.maxSegment == 0 {
+		return maxInt
+	}
+	return v.maxSegment
+}
@@ -0,0 +1,84 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestWithMaxSegment(t *testing.T) {
+	s := semver.MustNew(semver.WithMaxSegment(65535))
+	tests := []struct {
+		name    string
+		version string
+		part    string
+	}{
+		{"Major", "65536.0.0", "major"},
+		{"Minor", "1.70000.0", "minor"},
+		{"Patch", "1.2.65536-rc.1", "patch"},
+		{"Int", "1.2.99999999999999999999", "patch"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t2 *testing.T) {
+			if s.Valid(test.version) {
+				t2.Fatalf("expected `%s` to be invalid", test.version)
+			}
+			_, err := s.Parse(test.version)
+			overflowErr, ok := errors.Cause(err).(*semver.OverflowError)
+			if !ok {
+				t2.Fatalf("expected an *OverflowError, got %v", err)
+			}
+			if overflowErr.Part != test.part {
+				t2.Fatalf("expected part `%s`, got `%s`", test.part, overflowErr.Part)
+			}
+		})
+	}
+
+	if !s.Valid("65535.65535.65535") {
+		t.Fatal("expected the max segment itself to be valid")
+	}
+	v, err := s.Parse("1.65535.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = v.Bump(semver.BumpMinor)
+	overflowErr, ok := errors.Cause(err).(*semver.OverflowError)
+	if !ok {
+		t.Fatalf("expected an *OverflowError, got %v", err)
+	}
+	if overflowErr.Part != "minor" || overflowErr.Value != "65536" {
+		t.Fatalf("expected minor `65536`, got %s `%s`", overflowErr.Part, overflowErr.Value)
+	}
+	next, err := v.Bump(semver.BumpMajor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.String() != "2.0.0" {
+		t.Fatalf("expected `2.0.0`, got `%s`", next)
+	}
+	patched, err := next.Bump(semver.BumpPatch)
+	if err != nil || patched.String() != "2.0.1" {
+		t.Fatalf("expected `2.0.1`, got %v, %v", patched, err)
+	}
+
+	if _, err := semver.New(semver.WithMaxSegment(0)); err == nil {
+		t.Fatal("expected an error for a max segment of 0")
+	}
+}
+
+func TestBumpOverflow(t *testing.T) {
+	s := semver.MustNew()
+	v, err := s.Parse("1.2.9223372036854775807")
+	if err != nil {
+		t.Skip("ints are smaller than 64 bits")
+	}
+	_, err = v.Bump(semver.BumpPatch)
+	overflowErr, ok := errors.Cause(err).(*semver.OverflowError)
+	if !ok {
+		t.Fatalf("expected an *OverflowError, got %v", err)
+	}
+	if overflowErr.Value != "9223372036854775808" {
+		t.Fatalf("expected `9223372036854775808`, got `%s`", overflowErr.Value)
+	}
+}
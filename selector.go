@@ -0,0 +1,178 @@
+package semver
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// Skipped is a version that was passed over during a selection, with the reason why.
+type Skipped struct {
+	Version string
+	Reason  string
+}
+
+// Selection is the outcome of a selection. Skipped holds the candidates that would have been
+// chosen over Version if they weren't denied, highest first.
+type Selection struct {
+	Version string
+	Skipped []Skipped
+}
+
+// Selector picks versions from lists while skipping the yanked or blocked versions on its deny-list.
+// It's safe for concurrent use.
+type Selector struct {
+	semver *Semver
+	mutex  sync.RWMutex
+	denied map[string]string
+}
+
+// NewSelector returns a new instance of Selector with an empty deny-list.
+func NewSelector(semver *Semver) *Selector {
+	return &Selector{
+		semver: semver,
+		denied: map[string]string{},
+	}
+}
+
+// Deny adds the version to the deny-list with the reason it shouldn't be selected.
+// Build metadata is ignored, so denying `1.2.3` also denies `1.2.3+build.1`.
+func (s *Selector) Deny(version string, reason string) error {
+	if !s.semver.Valid(version) {
+		return errors.Errorf("version `%s` is invalid", version)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.denied[stripBuild(version)] = reason
+	return nil
+}
+
+// Allow removes the version from the deny-list.
+func (s *Selector) Allow(version string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.denied, stripBuild(version))
+}
+
+// Denied returns the reason the version is on the deny-list, if it is.
+func (s *Selector) Denied(version string) (string, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	reason, ok := s.denied[stripBuild(version)]
+	return reason, ok
+}
+
+// MaxSatisfying returns the highest version that is between start and end and not denied.
+// Like InRange, either bound can be Unbounded.
+func (s *Selector) MaxSatisfying(versions []string, start string, end string) (string, error) {
+	selection, err := s.SelectMaxSatisfying(versions, start, end)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return selection.Version, nil
+}
+
+// SelectMaxSatisfying is like MaxSatisfying, but also surfaces the newer versions that were skipped.
+func (s *Selector) SelectMaxSatisfying(versions []string, start string, end string) (*Selection, error) {
+	if start != Unbounded && !s.semver.Valid(start) {
+		return nil, errors.Errorf("start `%s` is invalid", start)
+	}
+	if end != Unbounded && !s.semver.Valid(end) {
+		return nil, errors.Errorf("end `%s` is invalid", end)
+	}
+	selection, err := s.selectMax(versions, func(version string) (bool, error) {
+		if start != Unbounded {
+			c, err := s.semver.compare(version, start)
+			if err != nil || c < 0 {
+				return false, errors.Trace(err)
+			}
+		}
+		if end != Unbounded {
+			c, err := s.semver.compare(version, end)
+			if err != nil || c > 0 {
+				return false, errors.Trace(err)
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if selection.Version == "" {
+		return selection, errors.NotFoundf("version between `%s` and `%s`", start, end)
+	}
+	return selection, nil
+}
+
+// LatestStable returns the highest version that has no prerelease tag and isn't denied.
+func (s *Selector) LatestStable(versions []string) (string, error) {
+	selection, err := s.SelectLatestStable(versions)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return selection.Version, nil
+}
+
+// SelectLatestStable is like LatestStable, but also surfaces the newer versions that were skipped.
+func (s *Selector) SelectLatestStable(versions []string) (*Selection, error) {
+	selection, err := s.selectMax(versions, func(version string) (bool, error) {
+		return !strings.Contains(stripBuild(version), "-"), nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if selection.Version == "" {
+		return selection, errors.NotFoundf("stable version")
+	}
+	return selection, nil
+}
+
+func (s *Selector) selectMax(versions []string, matches func(version string) (bool, error)) (*Selection, error) {
+	selection := &Selection{}
+	var selected *Version
+	var skipped []*Version
+	reasons := map[*Version]string{}
+	for k := range versions {
+		v, err := s.semver.Parse(versions[k])
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ok, err := matches(versions[k])
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !ok {
+			continue
+		}
+		if reason, denied := s.Denied(versions[k]); denied {
+			skipped = append(skipped, v)
+			reasons[v] = reason
+			continue
+		}
+		if selected == nil || v.Compare(selected) > 0 {
+			selected = v
+		}
+	}
+	if selected != nil {
+		selection.Version = selected.Original()
+	}
+	sort.SliceStable(skipped, func(i, j int) bool {
+		return skipped[i].Compare(skipped[j]) > 0
+	})
+	for k := range skipped {
+		if selected != nil && skipped[k].Compare(selected) <= 0 {
+			break
+		}
+		selection.Skipped = append(selection.Skipped, Skipped{Version: skipped[k].Original(), Reason: reasons[skipped[k]]})
+	}
+	return selection, nil
+}
+
+func stripBuild(version string) string {
+	if i := strings.IndexByte(version, '+'); i >= 0 {
+		return version[:i]
+	}
+	return version
+}
@@ -0,0 +1,89 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+var selectorVersions = []string{"1.0.0", "1.1.0", "1.2.0-rc.1", "1.2.0", "1.3.0+build.7", "2.0.0"}
+
+func newSelector(t *testing.T) *semver.Selector {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	selector := semver.NewSelector(s)
+	if err := selector.Deny("1.3.0", "yanked: broken migration"); err != nil {
+		t.Fatal(err)
+	}
+	if err := selector.Deny("2.0.0", "blocked: pending security review"); err != nil {
+		t.Fatal(err)
+	}
+	return selector
+}
+
+func TestSelectorMaxSatisfying(t *testing.T) {
+	selector := newSelector(t)
+	cases := []struct {
+		start    string
+		end      string
+		expected string
+	}{
+		{"1.0.0", "", "1.2.0"},
+		{"1.0.0", "1.1.5", "1.1.0"},
+		{"1.2.0-rc.1", "1.2.0-rc.1", "1.2.0-rc.1"},
+		{semver.Unbounded, "1.2.0-rc.1", "1.2.0-rc.1"},
+		{semver.Unbounded, semver.Unbounded, "1.2.0"},
+	}
+	for k := range cases {
+		c := cases[k]
+		version, err := selector.MaxSatisfying(selectorVersions, c.start, c.end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != c.expected {
+			t.Fatalf("expected `%s` between `%s` and `%s`, got `%s`", c.expected, c.start, c.end, version)
+		}
+	}
+	if _, err := selector.MaxSatisfying(selectorVersions, "3.0.0", ""); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if _, err := selector.MaxSatisfying([]string{invalidVersions[0]}, "1.0.0", ""); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := selector.MaxSatisfying(selectorVersions, invalidVersions[0], ""); err == nil {
+		t.Fatal("expected an error for an invalid start")
+	}
+}
+
+func TestSelectorSkipped(t *testing.T) {
+	selector := newSelector(t)
+	selection, err := selector.SelectLatestStable(selectorVersions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if selection.Version != "1.2.0" {
+		t.Fatalf("expected `1.2.0`, got `%s`", selection.Version)
+	}
+	if len(selection.Skipped) != 2 || selection.Skipped[0].Version != "2.0.0" ||
+		selection.Skipped[1].Version != "1.3.0+build.7" || selection.Skipped[1].Reason != "yanked: broken migration" {
+		t.Fatalf("unexpected skipped versions %+v", selection.Skipped)
+	}
+
+	selector.Allow("2.0.0")
+	version, err := selector.LatestStable(selectorVersions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "2.0.0" {
+		t.Fatalf("expected `2.0.0` after allowing it again, got `%s`", version)
+	}
+	if _, err := selector.LatestStable([]string{"1.0.0-rc.1"}); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if err := selector.Deny(invalidVersions[0], ""); err == nil {
+		t.Fatal("expected an error denying an invalid version")
+	}
+}
@@ -1,20 +1,34 @@
 package semver
 
 import (
+	"log/slog"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/espal-digital-development/semver/core"
 	"github.com/juju/errors"
 )
 
-var _ Versioning = &Semver{}
+var (
+	_ Versioning   = &Semver{}
+	_ VersioningV2 = &Semver{}
+)
 
 const (
 	expectedChunksWithTag      = 2
 	exptectedPartsWithRevision = 3
+
+	validPattern = `^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-]` +
+		`[0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
 )
 
+// Unbounded can be passed as the start or end of a range to leave that side of it open.
+const Unbounded = ""
+
+// defaultSemver is used where no instance can be passed in, like when unmarshalling.
+var defaultSemver = &Semver{}
+
 // Versioning represents an object that provides validation tools to check a versioning system's versions.
 type Versioning interface {
 	Valid(version string) bool
@@ -23,31 +37,150 @@ type Versioning interface {
 	SmallerThanOrEqual(version string, compare string) (bool, error)
 }
 
+// VersioningV2 extends Versioning with comparing and parsing. It embeds Versioning, so a VersioningV2
+// can be passed wherever a Versioning is expected.
+type VersioningV2 interface {
+	Versioning
+	Compare(version string, compare string) (int, error)
+	Parse(version string) (*Version, error)
+	ParseConstraint(expression string) (*Constraint, error)
+}
+
 // Semver validator to do checks based on the semver 2.0.0 spec.
 type Semver struct {
-	reValid *regexp.Regexp
+	channels  *channelOrder
+	epoch     bool
+	maxLength int
+
+	includePrerelease bool
+	logger            *slog.Logger
+	metrics           Metrics
+	quad              bool
+	maxSegment        int
+	foldCase          bool
+	pattern           *regexp.Regexp
+	grammar           *grammar
+	constraints       *constraintCache
+	vPrefix           bool
+	strictBuild       bool
+	scheme            Scheme
+	schemeVersioning  *schemeVersioning
+	stabilities       map[string]StabilityLevel
 }
 
-// Valid checks if the given version is a valid semver format.
+// Option configures a Semver.
+type Option func(s *Semver) error
+
+// Valid checks if the given version is a valid semver format. Versions with a major, minor
+// or revision that doesn't fit in an int can't be compared and are invalid as well.
 func (s *Semver) Valid(version string) bool {
-	return s.reValid.MatchString(version)
+	if s.schemeVersioning != nil {
+		valid := s.schemeVersioning.valid(s.trimPrefix(version))
+		s.validated(valid)
+		return valid
+	}
+	if converted, err := s.input(version); err != nil || !s.valid(converted) {
+		if s.logger != nil {
+			s.logFailure("valid", version, s.invalidReason(version))
+		}
+		s.validated(false)
+		return false
+	}
+	s.validated(true)
+	return true
+}
+
+func (s *Semver) valid(version string) bool {
+	version = s.trimPrefix(version)
+	original := version
+	if !s.validSyntax(version) {
+		return false
+	}
+	if epoch, rest, ok := s.splitEpoch(version); ok {
+		if _, err := strconv.Atoi(epoch); err != nil {
+			return false
+		}
+		version = rest
+	}
+	if !coreFitsInt(version) {
+		return false
+	}
+	if s.maxSegment > 0 {
+		if _, err := s.buildVersion(original); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// validSyntax checks the version against the semver grammar, without checking if the parts fit in an int.
+func (s *Semver) validSyntax(version string) bool {
+	if s.tooLong(version) {
+		return false
+	}
+	version = s.trimPrefix(version)
+	if version == "" || version[0] < '0' || version[0] > '9' {
+		return false
+	}
+	if epoch, rest, ok := s.splitEpoch(version); ok {
+		if !validNumericIdentifier(epoch) {
+			return false
+		}
+		version = rest
+	}
+	if rest, fourth, ok := s.splitQuad(version); ok {
+		if !validNumericIdentifier(fourth) {
+			return false
+		}
+		version = rest
+	}
+	_, err := core.Parse(version)
+	return err != core.ErrInvalid
+}
+
+func coreFitsInt(version string) bool {
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+	for _, part := range strings.Split(version, ".") {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
 }
 
 type semVersion struct {
+	epoch    int
 	major    int
 	minor    int
 	revision int
+	fourth   int
+	quad     bool
 	tag      string
+	build    string
+	channels *channelOrder
+
+	maxSegment  int
+	strictBuild bool
 }
 
-// InRange checks if the version is between the given start and end versions.
+// InRange checks if the version is between the given start and end versions, inclusive.
+// Either bound can be Unbounded to leave that side of the range open.
 func (s *Semver) InRange(version string, start string, end string) (bool, error) {
-	greaterThanOrEqual, err := s.GreaterThanOrEqual(version, start)
-	if err != nil {
-		return false, errors.Trace(err)
+	if !s.Valid(version) {
+		return false, errors.Errorf("version `%s` is invalid", version)
+	}
+	var err error
+	greaterThanOrEqual := true
+	if start != Unbounded {
+		greaterThanOrEqual, err = s.GreaterThanOrEqual(version, start)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
 	}
 	smallerThanOrEqual := true
-	if end != "" {
+	if end != Unbounded {
 		smallerThanOrEqual, err = s.SmallerThanOrEqual(version, end)
 		if err != nil {
 			return false, errors.Trace(err)
@@ -56,76 +189,76 @@ func (s *Semver) InRange(version string, start string, end string) (bool, error)
 	return greaterThanOrEqual && smallerThanOrEqual, nil
 }
 
-// GreaterThanOrEqual checks if the given version is greater than or equal to the compare version.
+// GreaterThanOrEqual checks if the given version is greater than or equal to the compare version. Only
+// the epoch, major, minor, patch and revision are compared, so prereleases of a version are equal to it.
 func (s *Semver) GreaterThanOrEqual(version string, compare string) (bool, error) {
-	if !s.Valid(version) {
-		return false, errors.Errorf("version `%s` is invalid", version)
-	}
-	if !s.Valid(compare) {
-		return false, errors.Errorf("compare `%s` is invalid", compare)
-	}
-	semVersion, err := s.buildVersion(version)
+	c, err := s.compareCore(version, compare)
 	if err != nil {
 		return false, errors.Trace(err)
 	}
-	semCompare, err := s.buildVersion(compare)
-	if err != nil {
-		return false, errors.Trace(err)
-	}
-	if semVersion.major < semCompare.major {
-		return false, nil
-	}
-	if semVersion.major > semCompare.major {
-		return true, nil
-	}
-	if semVersion.minor < semCompare.minor {
-		return false, nil
-	}
-	if semVersion.minor > semCompare.minor {
-		return true, nil
-	}
-	return semVersion.revision >= semCompare.revision, nil
+	return c >= 0, nil
 }
 
-// SmallerThanOrEqual checks if the given version is smaller than or equal to the compare version.
+// SmallerThanOrEqual checks if the given version is smaller than or equal to the compare version. Only
+// the epoch, major, minor, patch and revision are compared, so prereleases of a version are equal to it.
 func (s *Semver) SmallerThanOrEqual(version string, compare string) (bool, error) {
-	if !s.Valid(version) {
-		return false, errors.Errorf("version `%s` is invalid", version)
-	}
-	if !s.Valid(compare) {
-		return false, errors.Errorf("compare `%s` is invalid", compare)
-	}
-	semVersion, err := s.buildVersion(version)
+	c, err := s.compareCore(version, compare)
 	if err != nil {
 		return false, errors.Trace(err)
 	}
-	semCompare, err := s.buildVersion(compare)
+	return c <= 0, nil
+}
+
+// compareCore validates and compares both versions by their core, or by the scheme of the Semver.
+func (s *Semver) compareCore(version string, compare string) (int, error) {
+	if s.schemeVersioning != nil {
+		c, err := s.schemeVersioning.compareValid(s.trimPrefix(version), s.trimPrefix(compare))
+		return c, errors.Trace(err)
+	}
+	semVersion, semCompare, err := s.buildPair(version, compare)
 	if err != nil {
-		return false, errors.Trace(err)
+		return 0, errors.Trace(err)
 	}
-	if semVersion.major > semCompare.major {
-		return false, nil
+	return compareCores(semVersion, semCompare), nil
+}
+
+func (s *Semver) buildVersion(version string) (*semVersion, error) {
+	if err := s.checkLeadingZeros(version); err != nil {
+		return nil, errors.Trace(err)
 	}
-	if semVersion.major < semCompare.major {
-		return true, nil
+	original := version
+	version = s.trimPrefix(version)
+	semVersion := &semVersion{channels: s.channels, maxSegment: s.maxSegment, strictBuild: s.strictBuild}
+	var err error
+	if epoch, rest, ok := s.splitEpoch(version); ok {
+		semVersion.epoch, err = atoiPart(original, "epoch", epoch)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		version = rest
 	}
-	if semVersion.minor > semCompare.minor {
-		return false, nil
+	if rest, fourth, ok := s.splitQuad(version); ok {
+		semVersion.fourth, err = atoiPart(original, "revision", fourth)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		semVersion.quad = true
+		version = rest
 	}
-	if semVersion.minor < semCompare.minor {
-		return true, nil
+	if strings.Contains(version, "+") {
+		chunks := strings.SplitN(version, "+", 2)
+		semVersion.build = chunks[1]
+		version = chunks[0]
 	}
-	return semVersion.revision <= semCompare.revision, nil
-}
-
-func (s *Semver) buildVersion(version string) (*semVersion, error) {
-	semVersion := &semVersion{}
 	if strings.Contains(version, "-") {
 		chunks := strings.SplitN(version, "-", 2)
 		if len(chunks) != expectedChunksWithTag {
 			return nil, errors.Errorf("versions with a tag should be 2 chunks. Got %d", len(chunks))
 		}
 		semVersion.tag = chunks[1]
+		if s.foldCase {
+			semVersion.tag = strings.ToLower(semVersion.tag)
+		}
 		version = chunks[0]
 	}
 	versionParts := strings.Split(version, ".")
@@ -133,32 +266,94 @@ func (s *Semver) buildVersion(version string) (*semVersion, error) {
 	if versionPartsLength != 2 && versionPartsLength != 3 {
 		return nil, errors.Errorf("versions should be 2 or 3 parts. Got %d", versionPartsLength)
 	}
-	var err error
-	semVersion.major, err = strconv.Atoi(versionParts[0])
+	semVersion.major, err = atoiPart(original, "major", versionParts[0])
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	semVersion.minor, err = strconv.Atoi(versionParts[1])
+	semVersion.minor, err = atoiPart(original, "minor", versionParts[1])
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	if versionPartsLength == exptectedPartsWithRevision {
-		semVersion.revision, err = strconv.Atoi(versionParts[2])
+		semVersion.revision, err = atoiPart(original, "patch", versionParts[2])
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
 	}
+	if err := s.checkMaxSegment(original, semVersion); err != nil {
+		return nil, errors.Trace(err)
+	}
 	return semVersion, nil
 }
 
+// checkLeadingZeros returns a *LeadingZeroError for the first numeric identifier of the version
+// that has a leading zero. Other syntax errors are left to the caller.
+func (s *Semver) checkLeadingZeros(version string) error {
+	original := version
+	version = s.trimPrefix(version)
+	parts := make([]string, 0, 4)
+	names := make([]string, 0, 4)
+	if epoch, rest, ok := s.splitEpoch(version); ok {
+		parts = append(parts, epoch)
+		names = append(names, "epoch")
+		version = rest
+	}
+	version, fourth, quad := s.splitQuad(version)
+	if i := strings.IndexByte(version, '+'); i >= 0 {
+		version = version[:i]
+	}
+	var tag string
+	if i := strings.IndexByte(version, '-'); i >= 0 {
+		version, tag = version[:i], version[i+1:]
+	}
+	for k, part := range strings.Split(version, ".") {
+		if k < len(coreParts) {
+			parts = append(parts, part)
+			names = append(names, coreParts[k])
+		}
+	}
+	if quad {
+		parts = append(parts, fourth)
+		names = append(names, "revision")
+	}
+	if tag != "" {
+		for _, identifier := range strings.Split(tag, ".") {
+			parts = append(parts, identifier)
+			names = append(names, "prerelease")
+		}
+	}
+	for k := range parts {
+		if len(parts[k]) > 1 && parts[k][0] == '0' && isNumeric(parts[k]) {
+			return errors.Trace(&LeadingZeroError{Version: original, Part: names[k], Value: parts[k]})
+		}
+	}
+	return nil
+}
+
+var coreParts = []string{"major", "minor", "patch"}
+
+// atoiPart converts a numeric part, returning an *OverflowError when it doesn't fit in an int.
+func atoiPart(version string, part string, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if numErr, ok := err.(*strconv.NumError); ok && numErr.Err == strconv.ErrRange {
+		return 0, errors.Trace(&OverflowError{Version: version, Part: part, Value: value})
+	}
+	return n, errors.Trace(err)
+}
+
 // New returns a new instance ofSemver.
-func New() (*Semver, error) {
+func New(options ...Option) (*Semver, error) {
 	s := &Semver{}
 	var err error
-	s.reValid, err = regexp.Compile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-]` +
-		`[0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
-	if err != nil {
-		return nil, errors.Trace(err)
+	for _, option := range options {
+		if err := applyOption(s, option); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if s.foldCase && s.channels != nil {
+		if s.channels, err = s.channels.folded(); err != nil {
+			return nil, errors.Trace(err)
+		}
 	}
 	return s, nil
 }
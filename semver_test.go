@@ -25,6 +25,7 @@ var (
 		"1.2.",
 		"9.7.0.",
 		"3.8.2-",
+		"99999999999999999999.0.0",
 	}
 	greaterThanVersions = [][]string{
 		{"1.2.3", "0.0.1"},
@@ -33,12 +34,14 @@ var (
 		{"1.0.1", "1.0.0"},
 		{"2.12.13", "1.33.44"},
 		{"2.12.13", "1.33.44"},
+		{"1.0.0-rc.1", "1.0.0"},
 	}
 	smallerThanVersions = [][]string{
 		{"0.0.1", "0.0.2"},
 		{"0.2.1", "0.2.2"},
 		{"0.1.2", "0.2.0"},
 		{"0.20.19", "1.1.2"},
+		{"1.0.0", "1.0.0-rc.1"},
 	}
 	inRangeVersions = [][]string{
 		{"12.13.14", "11.22.33", "13.44.55"},
@@ -47,6 +50,9 @@ var (
 		{"11.22.33", "11.22.33", "13.22.33"},
 		{"12.13.14", "11.22.33", "13.22.33"},
 		{"11.22.33", "11.22.33", "13.22.33-hotfix"},
+		{"12.13.14", "", "13.44.55"},
+		{"12.13.14", semver.Unbounded, semver.Unbounded},
+		{"11.22.33-rc.1", "11.22.33", "13.22.33"},
 	}
 	outOfRangeVersions = [][]string{
 		{"1.13.14", "11.22.33", "13.44.55"},
@@ -54,6 +60,7 @@ var (
 		{"1.13.14", "10.0.1", "12.22.33"},
 		{"11.22.32", "11.22.33", "11.22.33"},
 		{"11.22.33", "11.22.31", "11.22.32-hotfix"},
+		{"13.44.56", semver.Unbounded, "13.44.55"},
 	}
 )
 
@@ -200,4 +207,54 @@ func TestInRangeErrors(t *testing.T) {
 	if err == nil || err == expectedErr {
 		t.Fatalf("expected error to be thrown `%s`", expectedErr.Error())
 	}
+	_, err = semver.InRange(wrongVersion, "", "")
+	if err == nil {
+		t.Fatal("expected an error for an invalid version in an unbounded range")
+	}
+}
+
+func TestLeadingZero(t *testing.T) {
+	s, err := semver.New(semver.WithEpoch())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		version string
+		part    string
+		value   string
+	}{
+		{"01.2.3", "major", "01"},
+		{"1.02.3", "minor", "02"},
+		{"1.2.003", "patch", "003"},
+		{"1.2.3-rc.01", "prerelease", "01"},
+		{"01:1.2.3", "epoch", "01"},
+		{"1.02", "minor", "02"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.version, func(t2 *testing.T) {
+			_, err := s.Parse(c.version)
+			leadingZeroErr, ok := errors.Cause(err).(*semver.LeadingZeroError)
+			if !ok {
+				t2.Fatalf("expected a *LeadingZeroError as cause, got %v", err)
+			}
+			if leadingZeroErr.Part != c.part || leadingZeroErr.Value != c.value {
+				t2.Fatalf("expected the %s `%s`, got the %s `%s`", c.part, c.value, leadingZeroErr.Part, leadingZeroErr.Value)
+			}
+			_, err = s.Compare("1.0.0", c.version)
+			if _, ok := errors.Cause(err).(*semver.LeadingZeroError); !ok {
+				t2.Fatalf("expected a *LeadingZeroError as cause when comparing, got %v", err)
+			}
+		})
+	}
+	for _, version := range []string{"1.2.3-0", "1.2.3-0a.00a", "1.2.3+01", "10.20.30"} {
+		if _, err := s.Parse(version); err != nil {
+			t.Fatalf("expected `%s` to parse, got %v", version, err)
+		}
+	}
+	if _, err := s.Parse("1.2.3-"); err == nil {
+		t.Fatal("expected an error for an empty prerelease")
+	} else if _, ok := errors.Cause(err).(*semver.LeadingZeroError); ok {
+		t.Fatalf("expected a plain invalid error, got %v", err)
+	}
 }
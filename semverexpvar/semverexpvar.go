@@ -0,0 +1,47 @@
+// Package semverexpvar reports the instrumentation events of a semver.Semver in an expvar.Map. It's
+// a separate package, as importing expvar registers its handler on http.DefaultServeMux.
+package semverexpvar
+
+import (
+	"expvar"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var _ semver.Metrics = &Metrics{}
+
+// Metrics counts the events in an expvar.Map, under the keys `validations`, `validation_failures`,
+// `comparisons`, `comparison_failures` and `<cache>_hits` and `<cache>_misses` per cache.
+type Metrics struct {
+	m *expvar.Map
+}
+
+// New returns Metrics that count in m, like one made by expvar.NewMap("semver").
+func New(m *expvar.Map) *Metrics {
+	return &Metrics{m: m}
+}
+
+// Validated implements semver.Metrics.
+func (e *Metrics) Validated(ok bool) {
+	e.m.Add("validations", 1)
+	if !ok {
+		e.m.Add("validation_failures", 1)
+	}
+}
+
+// Compared implements semver.Metrics.
+func (e *Metrics) Compared(ok bool) {
+	e.m.Add("comparisons", 1)
+	if !ok {
+		e.m.Add("comparison_failures", 1)
+	}
+}
+
+// CacheLookup implements semver.Metrics.
+func (e *Metrics) CacheLookup(cache string, hit bool) {
+	if hit {
+		e.m.Add(cache+"_hits", 1)
+		return
+	}
+	e.m.Add(cache+"_misses", 1)
+}
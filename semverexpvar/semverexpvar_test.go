@@ -0,0 +1,47 @@
+package semverexpvar_test
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/semverexpvar"
+)
+
+func TestMetrics(t *testing.T) {
+	m := new(expvar.Map).Init()
+	s, err := semver.New(semver.WithMetrics(semverexpvar.New(m)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Valid("1.2.3")
+	s.Valid("1.2")
+	if _, err := s.Parse("1.2.3-rc.1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Compare("1.2.3", "1.2.4"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Compare("1.2.3", "1.2"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	pool := semver.NewPool(s)
+	for _, version := range []string{"1.0.0", "1.0.0", "1.1.0"} {
+		if _, err := pool.Intern(version); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := map[string]string{
+		"validations":         "5",
+		"validation_failures": "1",
+		"comparisons":         "2",
+		"comparison_failures": "1",
+		"pool_hits":           "1",
+		"pool_misses":         "2",
+	}
+	for key, value := range expected {
+		if v := m.Get(key); v == nil || v.String() != value {
+			t.Fatalf("expected `%s` to be %s, got %v", key, value, v)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Package semverfile finds the version in a file, like a Go constant, package.json or Dockerfile, and
+// rewrites it atomically, which is the core of "bump the version in the repo" scripts.
+package semverfile
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+// Patterns for common files. The version is matched by the `version` group.
+const (
+	// PatternGo matches `Version = "1.2.3"` declarations, with an optional `v` prefix.
+	PatternGo = `\bVersion\s*=\s*"v?(?P<version>[^"\s]+)"`
+	// PatternJSON matches `"version": "1.2.3"` fields like the one of package.json.
+	PatternJSON = `"version"\s*:\s*"(?P<version>[^"\s]+)"`
+	// PatternDockerfile matches `ARG VERSION=1.2.3` and `ENV VERSION=1.2.3` instructions.
+	PatternDockerfile = `(?m)^(?:ARG|ENV)\s+VERSION[=\s](?P<version>\S+)`
+)
+
+// Replacer rewrites the version that a pattern matches in files.
+type Replacer struct {
+	semver  *semver.Semver
+	pattern *regexp.Regexp
+	group   int
+}
+
+// New returns a Replacer for the pattern, which should have a group named `version`.
+func New(s *semver.Semver, pattern string) (*Replacer, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	group := re.SubexpIndex("version")
+	if group < 0 {
+		return nil, errors.Errorf("pattern `%s` should have a group named `version`", pattern)
+	}
+	return &Replacer{semver: s, pattern: re, group: group}, nil
+}
+
+// Set rewrites the version in the file to version and returns the diff.
+func (r *Replacer) Set(path string, version string) (string, error) {
+	next, err := r.semver.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	diff, err := r.Replace(path, func(*semver.Version) (*semver.Version, error) {
+		return next, nil
+	})
+	return diff, errors.Trace(err)
+}
+
+// Bump bumps the version in the file and returns the diff.
+func (r *Replacer) Bump(path string, bump semver.Bump) (string, error) {
+	diff, err := r.Replace(path, func(current *semver.Version) (*semver.Version, error) {
+		return current.Bump(bump)
+	})
+	return diff, errors.Trace(err)
+}
+
+// Replace rewrites the version in the file to the one next returns for the current version, and
+// returns the diff in unified format. Every match in the file should hold the same valid version,
+// and not finding any fails with a NotFound error. The file is replaced atomically, by renaming a
+// rewritten copy over it, so readers never see a partial write.
+func (r *Replacer) Replace(path string, next func(current *semver.Version) (*semver.Version, error)) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	matches := r.pattern.FindAllSubmatchIndex(content, -1)
+	if len(matches) == 0 {
+		return "", errors.NotFoundf("version in `%s`", path)
+	}
+	var current *semver.Version
+	for _, match := range matches {
+		found := string(content[match[2*r.group]:match[2*r.group+1]])
+		v, err := r.semver.Parse(found)
+		if err != nil {
+			return "", errors.Annotatef(err, "`%s`", path)
+		}
+		if current != nil && v.Compare(current) != 0 {
+			return "", errors.Errorf("`%s` holds both version `%s` and `%s`", path, current.Original(), found)
+		}
+		current = v
+	}
+	v, err := next(current)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	replacement := []byte(v.String())
+
+	var rewritten []byte
+	var last int
+	for _, match := range matches {
+		rewritten = append(rewritten, content[last:match[2*r.group]]...)
+		rewritten = append(rewritten, replacement...)
+		last = match[2*r.group+1]
+	}
+	rewritten = append(rewritten, content[last:]...)
+	if err := writeAtomic(path, rewritten); err != nil {
+		return "", errors.Trace(err)
+	}
+	return diff(path, string(content), string(rewritten)), nil
+}
+
+// writeAtomic writes the content to a temporary file next to path and renames it over path,
+// keeping the file's permissions.
+func writeAtomic(path string, content []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return errors.Trace(err)
+	}
+	if err := tmp.Chmod(info.Mode().Perm()); err != nil {
+		_ = tmp.Close()
+		return errors.Trace(err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return errors.Trace(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.Rename(tmp.Name(), path))
+}
+
+// diff returns a unified diff of the changed lines. Replacing a version never adds or removes lines,
+// so the lines of both contents line up.
+func diff(path string, before string, after string) string {
+	beforeLines := strings.Split(before, "\n")
+	afterLines := strings.Split(after, "\n")
+	var b strings.Builder
+	b.WriteString("--- a/" + path + "\n+++ b/" + path + "\n")
+	for k := range beforeLines {
+		if beforeLines[k] == afterLines[k] {
+			continue
+		}
+		line := strconv.Itoa(k + 1)
+		b.WriteString("@@ -" + line + " +" + line + " @@\n-" + beforeLines[k] + "\n+" + afterLines[k] + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,136 @@
+package semverfile_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/semverfile"
+	"github.com/juju/errors"
+)
+
+func writeFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o640); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReplacer(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		name     string
+		pattern  string
+		content  string
+		bump     semver.Bump
+		expected string
+	}{
+		{"go", semverfile.PatternGo, "package app\n\n// Version of the app.\nconst Version = \"v1.2.3\"\n",
+			semver.BumpMinor, "package app\n\n// Version of the app.\nconst Version = \"v1.3.0\"\n"},
+		{"json", semverfile.PatternJSON, "{\n  \"name\": \"app\",\n  \"version\": \"1.2.3-rc.1\"\n}\n",
+			semver.BumpPatch, "{\n  \"name\": \"app\",\n  \"version\": \"1.2.4\"\n}\n"},
+		{"dockerfile", semverfile.PatternDockerfile, "FROM alpine\nARG VERSION=1.2.3\nLABEL version=$VERSION\nENV VERSION=1.2.3\n",
+			semver.BumpMajor, "FROM alpine\nARG VERSION=2.0.0\nLABEL version=$VERSION\nENV VERSION=2.0.0\n"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			path := writeFile(t2, "file", c.content)
+			r, err := semverfile.New(s, c.pattern)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if _, err := r.Bump(path, c.bump); err != nil {
+				t2.Fatal(err)
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if string(content) != c.expected {
+				t2.Fatalf("expected %q, got %q", c.expected, content)
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if info.Mode().Perm() != 0o640 {
+				t2.Fatalf("expected the permissions to be kept, got %v", info.Mode().Perm())
+			}
+			entries, err := os.ReadDir(filepath.Dir(path))
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if len(entries) != 1 {
+				t2.Fatalf("expected no temporary files to be left, got %d entries", len(entries))
+			}
+		})
+	}
+}
+
+func TestReplacerSetDiff(t *testing.T) {
+	s := semver.MustNew()
+	path := writeFile(t, "version.go", "package app\n\nconst Version = \"1.2.3\"\n")
+	r, err := semverfile.New(s, semverfile.PatternGo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := r.Set(path, "2.0.0-rc.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "--- a/" + path + "\n+++ b/" + path + "\n@@ -3 +3 @@\n-const Version = \"1.2.3\"\n+const Version = \"2.0.0-rc.1\"\n"
+	if diff != expected {
+		t.Fatalf("expected %q, got %q", expected, diff)
+	}
+}
+
+func TestReplacerErrors(t *testing.T) {
+	s := semver.MustNew()
+	if _, err := semverfile.New(s, `Version = "(.+)"`); err == nil {
+		t.Fatal("expected an error for a pattern without a version group")
+	}
+	if _, err := semverfile.New(s, `(?P<version>`); err == nil {
+		t.Fatal("expected an error for an invalid pattern")
+	}
+	r, err := semverfile.New(s, semverfile.PatternJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name    string
+		content string
+	}{
+		{"invalid version", `{"version": "1.2"}`},
+		{"different versions", `{"version": "1.2.3", "dependencies": [{"version": "2.0.0"}]}`},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			path := writeFile(t2, "package.json", c.content)
+			if _, err := r.Bump(path, semver.BumpPatch); err == nil {
+				t2.Fatal("expected an error")
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if string(content) != c.content {
+				t2.Fatalf("expected the file to be unchanged, got %q", content)
+			}
+		})
+	}
+	path := writeFile(t, "package.json", `{"name": "app"}`)
+	if _, err := r.Bump(path, semver.BumpPatch); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error without a version, got %v", err)
+	}
+	if _, err := r.Set(path, "1.2"); err == nil {
+		t.Fatal("expected an error setting an invalid version")
+	}
+	if _, err := r.Bump(filepath.Join(t.TempDir(), "missing.json"), semver.BumpPatch); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
@@ -0,0 +1,111 @@
+// Package semverhttp negotiates API versions over HTTP by reading the requested version from a
+// request header and matching it against the versions a server supports. It also reads the client
+// versions from User-Agent headers.
+package semverhttp
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+type contextKey struct{}
+
+// DefaultHeaders are the headers the requested version is read from, in order of preference.
+var DefaultHeaders = []string{"Accept-Version", "X-API-Version"}
+
+// Option configures a Middleware.
+type Option func(m *Middleware)
+
+// WithHeaders reads the requested version from the given headers instead of DefaultHeaders.
+func WithHeaders(headers ...string) Option {
+	return func(m *Middleware) {
+		m.headers = headers
+	}
+}
+
+// Required rejects requests without a version header. By default they're passed on without a version.
+func Required() Option {
+	return func(m *Middleware) {
+		m.required = true
+	}
+}
+
+// Middleware validates the requested version and injects it into the request context.
+// Invalid versions are rejected with 400 Bad Request and unsupported ones with 406 Not Acceptable.
+type Middleware struct {
+	semver    *semver.Semver
+	supported *semver.Constraint
+	headers   []string
+	required  bool
+}
+
+// New returns a new instance of Middleware that accepts the versions satisfying the supported constraint.
+func New(s *semver.Semver, supported string, options ...Option) (*Middleware, error) {
+	constraint, err := s.ParseConstraint(supported)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	m := &Middleware{
+		semver:    s,
+		supported: constraint,
+		headers:   DefaultHeaders,
+	}
+	for k := range options {
+		options[k](m)
+	}
+	if len(m.headers) == 0 {
+		return nil, errors.New("at least one header is required")
+	}
+	return m, nil
+}
+
+// Handler wraps the next handler, only calling it for requests with an acceptable version.
+func (m *Middleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", strings.Join(m.headers, ", "))
+		requested, ok := m.requested(r)
+		if !ok {
+			if m.required {
+				http.Error(w, "the "+m.headers[0]+" header is required", http.StatusBadRequest)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+		v, err := m.semver.Parse(requested)
+		if err != nil {
+			http.Error(w, "version `"+requested+"` is invalid", http.StatusBadRequest)
+			return
+		}
+		if !m.supported.CheckVersion(v) {
+			http.Error(w, "version `"+requested+"` is not supported, only `"+m.supported.String()+"` is",
+				http.StatusNotAcceptable)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), v)))
+	})
+}
+
+func (m *Middleware) requested(r *http.Request) (string, bool) {
+	for k := range m.headers {
+		if value := strings.TrimSpace(r.Header.Get(m.headers[k])); value != "" {
+			return strings.TrimPrefix(value, "v"), true
+		}
+	}
+	return "", false
+}
+
+// NewContext returns a copy of the context that carries the version.
+func NewContext(ctx context.Context, v *semver.Version) context.Context {
+	return context.WithValue(ctx, contextKey{}, v)
+}
+
+// FromContext returns the version the middleware injected into the context, if any.
+func FromContext(ctx context.Context) (*semver.Version, bool) {
+	v, ok := ctx.Value(contextKey{}).(*semver.Version)
+	return v, ok
+}
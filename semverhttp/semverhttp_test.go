@@ -0,0 +1,83 @@
+package semverhttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/semverhttp"
+)
+
+func serve(t *testing.T, m *semverhttp.Middleware, headers map[string]string) (*httptest.ResponseRecorder, string) {
+	var seen string
+	handler := m.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = "none"
+		if v, ok := semverhttp.FromContext(r.Context()); ok {
+			seen = v.Original()
+		}
+	}))
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	for name, value := range headers {
+		r.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w, seen
+}
+
+func TestMiddleware(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := semverhttp.New(s, ">=1.2.0 <3.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		headers map[string]string
+		status  int
+		seen    string
+	}{
+		{map[string]string{"Accept-Version": "1.4.0"}, http.StatusOK, "1.4.0"},
+		{map[string]string{"X-API-Version": "v2.0.1"}, http.StatusOK, "2.0.1"},
+		{map[string]string{"Accept-Version": "2.1.0", "X-API-Version": "1.0.0"}, http.StatusOK, "2.1.0"},
+		{map[string]string{}, http.StatusOK, "none"},
+		{map[string]string{"Accept-Version": "1.0"}, http.StatusBadRequest, ""},
+		{map[string]string{"Accept-Version": "3.0.0"}, http.StatusNotAcceptable, ""},
+	}
+	for k := range cases {
+		c := cases[k]
+		w, seen := serve(t, m, c.headers)
+		if w.Code != c.status || seen != c.seen {
+			t.Fatalf("expected %d with `%s` for %v, got %d with `%s`", c.status, c.seen, c.headers, w.Code, seen)
+		}
+		if w.Header().Get("Vary") != "Accept-Version, X-API-Version" {
+			t.Fatalf("unexpected Vary header `%s`", w.Header().Get("Vary"))
+		}
+	}
+}
+
+func TestMiddlewareOptions(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := semverhttp.New(s, "^1.0.0", semverhttp.WithHeaders("Api-Version"), semverhttp.Required())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w, _ := serve(t, m, map[string]string{"Accept-Version": "1.0.0"}); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected a required header to be enforced, got %d", w.Code)
+	}
+	if w, seen := serve(t, m, map[string]string{"Api-Version": "1.1.0"}); w.Code != http.StatusOK || seen != "1.1.0" {
+		t.Fatalf("expected the custom header to be read, got %d with `%s`", w.Code, seen)
+	}
+	if _, err := semverhttp.New(s, ">>1"); err == nil {
+		t.Fatal("expected an error for an invalid constraint")
+	}
+	if _, err := semverhttp.New(s, "*", semverhttp.WithHeaders()); err == nil {
+		t.Fatal("expected an error without headers")
+	}
+}
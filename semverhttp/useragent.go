@@ -0,0 +1,74 @@
+package semverhttp
+
+import (
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+)
+
+// Product is a product token of a User-Agent header, like `MyApp/1.4.2`.
+type Product struct {
+	Name    string
+	Version *semver.Version
+}
+
+// ParseUserAgent returns the products of the User-Agent header that have a valid version, in the order
+// they appear. A `v` prefix of the version is allowed. Comments like `(Linux; x86_64)` are skipped and so
+// are products without a version or with an invalid one, like `Mozilla/5.0`.
+func ParseUserAgent(s *semver.Semver, userAgent string) []Product {
+	var products []Product
+	for _, token := range userAgentTokens(userAgent) {
+		i := strings.IndexByte(token, '/')
+		if i <= 0 {
+			continue
+		}
+		v, err := s.Parse(strings.TrimPrefix(token[i+1:], "v"))
+		if err != nil {
+			continue
+		}
+		products = append(products, Product{Name: token[:i], Version: v})
+	}
+	return products
+}
+
+// UserAgentVersion returns the version of the first product of the User-Agent header with the name,
+// which is matched case-insensitively.
+func UserAgentVersion(s *semver.Semver, userAgent string, name string) (*semver.Version, bool) {
+	for _, product := range ParseUserAgent(s, userAgent) {
+		if strings.EqualFold(product.Name, name) {
+			return product.Version, true
+		}
+	}
+	return nil, false
+}
+
+// userAgentTokens splits the header on whitespace and drops the comments, which can be nested and can
+// escape characters with a backslash.
+func userAgentTokens(userAgent string) []string {
+	var tokens []string
+	var token strings.Builder
+	depth := 0
+	for k := 0; k < len(userAgent); k++ {
+		c := userAgent[k]
+		switch {
+		case depth > 0 && c == '\\':
+			k++
+		case c == '(':
+			depth++
+		case depth > 0 && c == ')':
+			depth--
+		case depth > 0:
+		case c == ' ' || c == '\t':
+			if token.Len() > 0 {
+				tokens = append(tokens, token.String())
+				token.Reset()
+			}
+		default:
+			token.WriteByte(c)
+		}
+	}
+	if token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+	return tokens
+}
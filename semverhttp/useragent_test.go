@@ -0,0 +1,49 @@
+package semverhttp_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/semverhttp"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		userAgent string
+		expected  []string
+	}{
+		{"MyApp/1.4.2 (iPhone; iOS 17.1; Scale/3.00)", []string{"MyApp 1.4.2"}},
+		{"Mozilla/5.0 (X11; Linux x86_64) MyApp/v2.0.0-beta.1+build.7 Other/1.0", []string{"MyApp 2.0.0-beta.1+build.7"}},
+		{"cli/1.2.3 (nested (comment) with \\) escape) sdk/0.9.0", []string{"cli 1.2.3", "sdk 0.9.0"}},
+		{"curl/8.4.0", []string{"curl 8.4.0"}},
+		{"/1.2.3 NoVersion MyApp/1.2", nil},
+		{"", nil},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.userAgent, func(t2 *testing.T) {
+			products := semverhttp.ParseUserAgent(s, c.userAgent)
+			if len(products) != len(c.expected) {
+				t2.Fatalf("expected %v, got %v", c.expected, products)
+			}
+			for i := range products {
+				if got := products[i].Name + " " + products[i].Version.Original(); got != c.expected[i] {
+					t2.Fatalf("expected `%s`, got `%s`", c.expected[i], got)
+				}
+			}
+		})
+	}
+}
+
+func TestUserAgentVersion(t *testing.T) {
+	s := semver.MustNew()
+	userAgent := "Mozilla/5.0 (Linux) MyApp/1.4.2 MyApp/2.0.0"
+	v, ok := semverhttp.UserAgentVersion(s, userAgent, "myapp")
+	if !ok || v.Original() != "1.4.2" {
+		t.Fatalf("expected `1.4.2`, got %v", v)
+	}
+	if _, ok := semverhttp.UserAgentVersion(s, userAgent, "Mozilla"); ok {
+		t.Fatal("expected no version for a product with an invalid version")
+	}
+}
@@ -0,0 +1,158 @@
+// Package semverpb holds the protobuf representation of versions and constraints, so services can
+// exchange them over gRPC as structured messages rather than strings that need re-validation.
+//
+// The messages are generated from semver.proto:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative semver.proto
+package semverpb
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+// operators maps the constraint operators to their proto counterparts. It's ordered so that longer
+// operators are matched before their prefixes.
+var operators = []struct {
+	text     string
+	operator Operator
+}{
+	{"!=", Operator_OPERATOR_NOT_EQUAL},
+	{">=", Operator_OPERATOR_GREATER_THAN_OR_EQUAL},
+	{"<=", Operator_OPERATOR_LESS_THAN_OR_EQUAL},
+	{"=", Operator_OPERATOR_EQUAL},
+	{">", Operator_OPERATOR_GREATER_THAN},
+	{"<", Operator_OPERATOR_LESS_THAN},
+	{"~", Operator_OPERATOR_TILDE},
+	{"^", Operator_OPERATOR_CARET},
+}
+
+// ToProto converts the version to its proto message. A nil version converts to nil. The message has
+// no epoch or fourth part, so versions that have them fail with a NotSupported error.
+func ToProto(v *semver.Version) (*Version, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if v.Epoch != 0 || v.Revision != 0 {
+		return nil, errors.NotSupportedf("version `%s` with an epoch or a fourth part", v)
+	}
+	return &Version{
+		Major:      uint64(v.Major),
+		Minor:      uint64(v.Minor),
+		Patch:      uint64(v.Patch),
+		Prerelease: v.Prerelease,
+		Build:      v.Build,
+	}, nil
+}
+
+// FromProto converts the proto message to a version, validating it like Parse does.
+func FromProto(s *semver.Semver, pb *Version) (*semver.Version, error) {
+	if pb == nil {
+		return nil, errors.New("version is missing")
+	}
+	v, err := s.Parse(versionString(pb))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return v, nil
+}
+
+func versionString(pb *Version) string {
+	var b strings.Builder
+	b.WriteString(strconv.FormatUint(pb.GetMajor(), 10))
+	b.WriteByte('.')
+	b.WriteString(strconv.FormatUint(pb.GetMinor(), 10))
+	b.WriteByte('.')
+	b.WriteString(strconv.FormatUint(pb.GetPatch(), 10))
+	if pb.GetPrerelease() != "" {
+		b.WriteByte('-')
+		b.WriteString(pb.GetPrerelease())
+	}
+	if pb.GetBuild() != "" {
+		b.WriteByte('+')
+		b.WriteString(pb.GetBuild())
+	}
+	return b.String()
+}
+
+// ConstraintToProto converts the constraint to its proto message. A nil constraint converts to nil.
+// The message has no epoch or fourth part, so constraints with versions that have them can't be converted.
+func ConstraintToProto(c *semver.Constraint) (*Constraint, error) {
+	if c == nil {
+		return nil, nil
+	}
+	pb := &Constraint{}
+	for _, group := range c.Groups() {
+		pbGroup := &ComparatorGroup{}
+		for _, comparator := range group.Comparators {
+			pbComparator, err := comparatorToProto(comparator)
+			if err != nil {
+				return nil, errors.Annotatef(err, "constraint `%s`", c)
+			}
+			pbGroup.Comparators = append(pbGroup.Comparators, pbComparator)
+		}
+		pb.Groups = append(pb.Groups, pbGroup)
+	}
+	return pb, nil
+}
+
+func comparatorToProto(comparator *semver.Comparator) (*Comparator, error) {
+	if comparator.Operator == semver.OperatorAny {
+		return &Comparator{Operator: Operator_OPERATOR_ANY}, nil
+	}
+	v, err := ToProto(comparator.Version)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for k := range operators {
+		if operators[k].text == string(comparator.Operator) {
+			return &Comparator{Operator: operators[k].operator, Version: v}, nil
+		}
+	}
+	return nil, errors.Errorf("operator `%s` is invalid", comparator.Operator)
+}
+
+// ConstraintFromProto converts the proto message to a constraint, validating it like ParseConstraint does.
+func ConstraintFromProto(s *semver.Semver, pb *Constraint) (*semver.Constraint, error) {
+	if pb == nil {
+		return nil, errors.New("constraint is missing")
+	}
+	groups := make([]string, len(pb.GetGroups()))
+	for k, group := range pb.GetGroups() {
+		comparators := make([]string, len(group.GetComparators()))
+		for i, comparator := range group.GetComparators() {
+			text, err := comparatorString(comparator)
+			if err != nil {
+				return nil, errors.Annotatef(err, "group %d comparator %d", k, i)
+			}
+			comparators[i] = text
+		}
+		groups[k] = strings.Join(comparators, " ")
+	}
+	c, err := s.ParseConstraint(strings.Join(groups, " || "))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return c, nil
+}
+
+func comparatorString(pb *Comparator) (string, error) {
+	if pb.GetOperator() == Operator_OPERATOR_ANY {
+		if pb.GetVersion() != nil {
+			return "", errors.New("operator `*` can't have a version")
+		}
+		return "*", nil
+	}
+	if pb.GetVersion() == nil {
+		return "", errors.Errorf("operator `%s` has no version", pb.GetOperator())
+	}
+	for k := range operators {
+		if operators[k].operator == pb.GetOperator() {
+			return operators[k].text + versionString(pb.GetVersion()), nil
+		}
+	}
+	return "", errors.Errorf("operator `%s` is invalid", pb.GetOperator())
+}
@@ -0,0 +1,182 @@
+package semverpb_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/semverpb"
+	"github.com/juju/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestVersion(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, version := range []string{"0.0.0", "1.2.3", "1.0.0-rc.1", "1.0.0+build.5", "2.4.1-alpha.1+sha.0a1b2c"} {
+		t.Run(version, func(t2 *testing.T) {
+			v, err := s.Parse(version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			pb, err := semverpb.ToProto(v)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			data, err := proto.Marshal(pb)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			pb = &semverpb.Version{}
+			if err := proto.Unmarshal(data, pb); err != nil {
+				t2.Fatal(err)
+			}
+			back, err := semverpb.FromProto(s, pb)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if back.Original() != version || back.Compare(v) != 0 || back.Build != v.Build {
+				t2.Fatalf("expected `%s` to round-trip, got `%s`", version, back.Original())
+			}
+		})
+	}
+	if pb, err := semverpb.ToProto(nil); pb != nil || err != nil {
+		t.Fatal("expected nil to convert to nil")
+	}
+	options := semver.MustNew(semver.WithQuadSegments(), semver.WithEpoch())
+	for _, version := range []string{"1:1.2.3", "1.2.3.4"} {
+		if _, err := semverpb.ToProto(options.MustParse(version)); !errors.IsNotSupported(err) {
+			t.Fatalf("expected a NotSupported error for `%s`, got %v", version, err)
+		}
+	}
+}
+
+func TestVersionInvalid(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []*semverpb.Version{
+		nil,
+		{Major: 1, Prerelease: "01"},
+		{Major: 1, Prerelease: "rc..1"},
+		{Major: 1, Build: "a_b"},
+		{Major: 1 << 63},
+	}
+	for k := range cases {
+		if v, err := semverpb.FromProto(s, cases[k]); err == nil {
+			t.Fatalf("expected %v to be invalid, got `%s`", cases[k], v.Original())
+		}
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expression := range []string{"1.2.3", "*", ">=1.2.0 <2.0.0", "!=1.0.0-rc.1 || ~2.1.0", "^0.3.0, <=0.3.9 || >4.0.0+build"} {
+		t.Run(expression, func(t2 *testing.T) {
+			c, err := s.ParseConstraint(expression)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			pb, err := semverpb.ConstraintToProto(c)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			data, err := proto.Marshal(pb)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			decoded := &semverpb.Constraint{}
+			if err := proto.Unmarshal(data, decoded); err != nil {
+				t2.Fatal(err)
+			}
+			back, err := semverpb.ConstraintFromProto(s, decoded)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if back.String() != c.String() {
+				t2.Fatalf("expected `%s` to round-trip, got `%s`", c, back)
+			}
+		})
+	}
+}
+
+func TestConstraintStructure(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := s.ParseConstraint(">=1.2.0 <2.0.0 || *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb, err := semverpb.ConstraintToProto(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pb.Groups) != 2 || len(pb.Groups[0].Comparators) != 2 || len(pb.Groups[1].Comparators) != 1 {
+		t.Fatalf("unexpected groups %v", pb.Groups)
+	}
+	first := pb.Groups[0].Comparators[0]
+	if first.Operator != semverpb.Operator_OPERATOR_GREATER_THAN_OR_EQUAL || first.Version.Major != 1 || first.Version.Minor != 2 {
+		t.Fatalf("unexpected comparator %v", first)
+	}
+	if wildcard := pb.Groups[1].Comparators[0]; wildcard.Operator != semverpb.Operator_OPERATOR_ANY || wildcard.Version != nil {
+		t.Fatalf("unexpected comparator %v", wildcard)
+	}
+}
+
+func TestConstraintInvalid(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	version := &semverpb.Version{Major: 1}
+	cases := []*semverpb.Constraint{
+		nil,
+		{},
+		{Groups: []*semverpb.ComparatorGroup{{}}},
+		{Groups: []*semverpb.ComparatorGroup{{Comparators: []*semverpb.Comparator{{Operator: semverpb.Operator_OPERATOR_UNSPECIFIED, Version: version}}}}},
+		{Groups: []*semverpb.ComparatorGroup{{Comparators: []*semverpb.Comparator{{Operator: semverpb.Operator_OPERATOR_ANY, Version: version}}}}},
+		{Groups: []*semverpb.ComparatorGroup{{Comparators: []*semverpb.Comparator{{Operator: semverpb.Operator_OPERATOR_CARET}}}}},
+		{Groups: []*semverpb.ComparatorGroup{{Comparators: []*semverpb.Comparator{{Operator: semverpb.Operator(42), Version: version}}}}},
+		{Groups: []*semverpb.ComparatorGroup{{Comparators: []*semverpb.Comparator{{Operator: semverpb.Operator_OPERATOR_TILDE, Version: &semverpb.Version{Major: 1, Prerelease: "0a.01"}}}}}},
+	}
+	for k := range cases {
+		if c, err := semverpb.ConstraintFromProto(s, cases[k]); err == nil {
+			t.Fatalf("expected case %d to be invalid, got `%s`", k, c)
+		}
+	}
+}
+
+func TestConstraintOptions(t *testing.T) {
+	s := semver.MustNew(semver.WithQuadSegments(), semver.WithEpoch())
+	c, err := s.ParseConstraint(">=1.2.3.0 <2.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb, err := semverpb.ConstraintToProto(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	back, err := semverpb.ConstraintFromProto(s, pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.String() != ">=1.2.3 <2.0.0" {
+		t.Fatalf("expected `>=1.2.3 <2.0.0`, got `%s`", back)
+	}
+	for _, expression := range []string{"~1.2.3.4", ">=1:1.0.0"} {
+		c, err := s.ParseConstraint(expression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := semverpb.ConstraintToProto(c); !errors.IsNotSupported(err) {
+			t.Fatalf("expected a NotSupported error for `%s`, got %v", expression, err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Package semverprom reports the instrumentation events of a semver.Semver as Prometheus metrics.
+package semverprom
+
+import (
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ semver.Metrics = &Metrics{}
+
+// Metrics implements semver.Metrics with Prometheus counters:
+//
+//	semver_validations_total{result="ok|failed"}
+//	semver_comparisons_total{result="ok|failed"}
+//	semver_cache_lookups_total{cache="pool",result="hit|miss"}
+type Metrics struct {
+	validations  *prometheus.CounterVec
+	comparisons  *prometheus.CounterVec
+	cacheLookups *prometheus.CounterVec
+}
+
+// New returns Metrics with its counters registered to registerer, like prometheus.DefaultRegisterer.
+// Pass it to semver.WithMetrics.
+func New(registerer prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		validations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "semver",
+			Name:      "validations_total",
+			Help:      "Number of validated and parsed versions.",
+		}, []string{"result"}),
+		comparisons: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "semver",
+			Name:      "comparisons_total",
+			Help:      "Number of compared version pairs.",
+		}, []string{"result"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "semver",
+			Name:      "cache_lookups_total",
+			Help:      "Number of cache lookups.",
+		}, []string{"cache", "result"}),
+	}
+	for _, collector := range []prometheus.Collector{m.validations, m.comparisons, m.cacheLookups} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return m, nil
+}
+
+// Validated implements semver.Metrics.
+func (m *Metrics) Validated(ok bool) {
+	m.validations.WithLabelValues(result(ok, "ok", "failed")).Inc()
+}
+
+// Compared implements semver.Metrics.
+func (m *Metrics) Compared(ok bool) {
+	m.comparisons.WithLabelValues(result(ok, "ok", "failed")).Inc()
+}
+
+// CacheLookup implements semver.Metrics.
+func (m *Metrics) CacheLookup(cache string, hit bool) {
+	m.cacheLookups.WithLabelValues(cache, result(hit, "hit", "miss")).Inc()
+}
+
+func result(ok bool, yes string, no string) string {
+	if ok {
+		return yes
+	}
+	return no
+}
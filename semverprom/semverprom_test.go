@@ -0,0 +1,60 @@
+package semverprom_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/semverprom"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics, err := semverprom.New(registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := semver.New(semver.WithMetrics(metrics))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Valid("1.2.3")
+	s.Valid("1.2")
+	if _, err := s.Compare("1.2.3", "1.2.4"); err != nil {
+		t.Fatal(err)
+	}
+	pool := semver.NewPool(s)
+	for _, version := range []string{"1.0.0", "1.0.0"} {
+		if _, err := pool.Intern(version); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := `
+# HELP semver_cache_lookups_total Number of cache lookups.
+# TYPE semver_cache_lookups_total counter
+semver_cache_lookups_total{cache="pool",result="hit"} 1
+semver_cache_lookups_total{cache="pool",result="miss"} 1
+# HELP semver_comparisons_total Number of compared version pairs.
+# TYPE semver_comparisons_total counter
+semver_comparisons_total{result="ok"} 1
+# HELP semver_validations_total Number of validated and parsed versions.
+# TYPE semver_validations_total counter
+semver_validations_total{result="failed"} 1
+semver_validations_total{result="ok"} 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewRegisteredTwice(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	if _, err := semverprom.New(registry); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semverprom.New(registry); err == nil {
+		t.Fatal("expected an error registering the counters twice")
+	}
+}
@@ -0,0 +1,44 @@
+package semver
+
+import (
+	"strconv"
+)
+
+const sentinelMax = 1
+
+// Zero returns the `0.0.0` version, commonly used to mean "unknown". It's the zero value of Version,
+// so it's IsZero and compares equal to a parsed `0.0.0`.
+func Zero() Version {
+	return Version{}
+}
+
+// Max returns the sentinel that's higher than any parsed version, meant to be used as an unbounded upper limit.
+func Max() Version {
+	return Version{
+		Major:    maxInt,
+		Minor:    maxInt,
+		Patch:    maxInt,
+		original: strconv.Itoa(maxInt) + "." + strconv.Itoa(maxInt) + "." + strconv.Itoa(maxInt),
+		sentinel: sentinelMax,
+	}
+}
+
+// IsUnknown checks if the version is `0.0.0` without a prerelease, like Zero.
+func (v *Version) IsUnknown() bool {
+	return v.sentinel == 0 && v.Epoch == 0 && v.Major == 0 && v.Minor == 0 && v.Patch == 0 && v.Revision == 0 &&
+		v.Prerelease == ""
+}
+
+// IsUnbounded checks if the version is the Max sentinel.
+func (v *Version) IsUnbounded() bool {
+	return v.sentinel == sentinelMax
+}
+
+// IsUnknown checks if the given version is a valid `0.0.0` version, which is commonly used to mean "unknown".
+func (s *Semver) IsUnknown(version string) bool {
+	v, err := s.Parse(version)
+	if err != nil {
+		return false
+	}
+	return v.IsUnknown()
+}
@@ -0,0 +1,68 @@
+package semver_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestSentinelOrdering(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero, max := semver.Zero(), semver.Max()
+	maxInt := strconv.Itoa(int(^uint(0) >> 1))
+	for _, version := range []string{"0.0.0-alpha", "0.0.0", "1.2.3", maxInt + "." + maxInt + "." + maxInt} {
+		v, err := s.Parse(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if max.Compare(v) != 1 || v.Compare(&max) != -1 {
+			t.Fatalf("expected Max to be higher than `%s`", version)
+		}
+	}
+	if zero.Compare(s.MustParse("0.0.0")) != 0 || zero.Compare(&semver.Version{}) != 0 {
+		t.Fatal("expected Zero to equal 0.0.0")
+	}
+	if zero.Compare(s.MustParse("0.0.0-alpha")) != 1 {
+		t.Fatal("expected Zero to be higher than the 0.0.0 prereleases")
+	}
+	if zero.Compare(&max) != -1 || max.Compare(&zero) != 1 || max.Compare(&max) != 0 {
+		t.Fatal("expected Zero to be lower than Max")
+	}
+}
+
+func TestSentinelPredicates(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero, max := semver.Zero(), semver.Max()
+	if !zero.IsUnknown() || !zero.IsZero() || zero.IsUnbounded() {
+		t.Fatal("unexpected predicates for Zero")
+	}
+	if !max.IsUnbounded() || max.IsUnknown() {
+		t.Fatal("unexpected predicates for Max")
+	}
+	cases := map[string]bool{
+		"0.0.0":       true,
+		"0.0.0+build": true,
+		"0.0.0-rc.1":  false,
+		"0.0.1":       false,
+		"0.0":         false,
+	}
+	for version, unknown := range cases {
+		if s.IsUnknown(version) != unknown {
+			t.Fatalf("expected `%s` unknown to be %t", version, unknown)
+		}
+	}
+	v, err := s.Parse("0.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.IsUnbounded() {
+		t.Fatal("expected a parsed version to not be a sentinel")
+	}
+}
@@ -0,0 +1,159 @@
+package semver
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+)
+
+// VersionSet is a set of versions that are deduplicated by their precedence, so versions
+// which only differ in their build metadata are considered the same. The first one added is kept.
+// Versions can be marked as yanked, like on Cargo and PyPI, which keeps them in the set but skips
+// them when selecting a version, unless a constraint pins them.
+type VersionSet struct {
+	semver   *Semver
+	versions map[string]*Version
+	yanked   map[string]bool
+}
+
+// NewVersionSet returns a new, empty instance of VersionSet.
+func NewVersionSet(semver *Semver) *VersionSet {
+	return &VersionSet{
+		semver:   semver,
+		versions: map[string]*Version{},
+		yanked:   map[string]bool{},
+	}
+}
+
+// Add adds the versions to the set. When any of them is invalid none are added.
+func (s *VersionSet) Add(versions ...string) error {
+	parsed := make([]*Version, len(versions))
+	for k := range versions {
+		v, err := s.semver.Parse(versions[k])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		parsed[k] = v
+	}
+	for k := range parsed {
+		key := stripBuild(parsed[k].canonical())
+		if _, ok := s.versions[key]; !ok {
+			s.versions[key] = parsed[k]
+		}
+	}
+	return nil
+}
+
+// Len returns the number of unique versions in the set.
+func (s *VersionSet) Len() int {
+	return len(s.versions)
+}
+
+// Contains checks if the set has a version with the same precedence.
+func (s *VersionSet) Contains(version string) bool {
+	v, err := s.semver.Parse(version)
+	if err != nil {
+		return false
+	}
+	_, ok := s.versions[stripBuild(v.canonical())]
+	return ok
+}
+
+// Versions returns all versions in ascending order.
+func (s *VersionSet) Versions() []*Version {
+	versions := make([]*Version, 0, len(s.versions))
+	for _, v := range s.versions {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Compare(versions[j]) < 0
+	})
+	return versions
+}
+
+// MarkYanked marks the version in the set as yanked. It fails with a NotFound error when the set
+// doesn't have the version.
+func (s *VersionSet) MarkYanked(version string) error {
+	v, err := s.semver.Parse(version)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	key := stripBuild(v.canonical())
+	if _, ok := s.versions[key]; !ok {
+		return errors.NotFoundf("version `%s` in the set", version)
+	}
+	s.yanked[key] = true
+	return nil
+}
+
+// Yanked checks if the set has a version with the same precedence that's yanked.
+func (s *VersionSet) Yanked(version string) bool {
+	v, err := s.semver.Parse(version)
+	if err != nil {
+		return false
+	}
+	return s.yanked[stripBuild(v.canonical())]
+}
+
+// Max returns the highest version that isn't yanked, including prereleases, if there is one.
+func (s *VersionSet) Max() (*Version, bool) {
+	var max *Version
+	for key, v := range s.versions {
+		if !s.yanked[key] && (max == nil || v.Compare(max) > 0) {
+			max = v
+		}
+	}
+	return max, max != nil
+}
+
+// Select returns the highest stable version that satisfies the constraint, like Constraint.Select.
+// Yanked versions are skipped, unless the constraint pins them with `=`. It fails with a NotFound
+// error when no version satisfies the constraint.
+func (s *VersionSet) Select(c *Constraint) (*Version, error) {
+	var selected *Version
+	for key, v := range s.versions {
+		if v.Prerelease != "" || (s.yanked[key] && !c.pins(v)) || !c.CheckVersion(v) {
+			continue
+		}
+		if selected == nil || v.Compare(selected) > 0 {
+			selected = v
+		}
+	}
+	if selected == nil {
+		return nil, errors.NotFoundf("version satisfying `%s`", c)
+	}
+	return selected, nil
+}
+
+// LatestStable returns the highest version without a prerelease tag that isn't yanked, if there is one.
+func (s *VersionSet) LatestStable() (*Version, bool) {
+	var latest *Version
+	for key, v := range s.versions {
+		if v.Prerelease == "" && !s.yanked[key] && (latest == nil || v.Compare(latest) > 0) {
+			latest = v
+		}
+	}
+	return latest, latest != nil
+}
+
+// LatestPerMajor returns the highest stable version that isn't yanked of every major in ascending order.
+// Majors that only have prereleases or yanked versions are left out.
+func (s *VersionSet) LatestPerMajor() []*Version {
+	latest := map[int]*Version{}
+	for key, v := range s.versions {
+		if v.Prerelease != "" || s.yanked[key] {
+			continue
+		}
+		if current, ok := latest[v.Major]; !ok || v.Compare(current) > 0 {
+			latest[v.Major] = v
+		}
+	}
+	versions := make([]*Version, 0, len(latest))
+	for _, v := range latest {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Major < versions[j].Major
+	})
+	return versions
+}
@@ -0,0 +1,133 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func originals(versions []*semver.Version) string {
+	result := make([]string, len(versions))
+	for k := range versions {
+		result[k] = versions[k].Original()
+	}
+	return strings.Join(result, " ")
+}
+
+func newVersionSet(t *testing.T) *semver.VersionSet {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := semver.NewVersionSet(s)
+	err = set.Add("1.0.0", "1.2.0+build.1", "1.2.0+build.2", "1.2.0", "2.0.0-rc.1", "0.9.3", "1.10.0", "3.0.0-beta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return set
+}
+
+func TestVersionSet(t *testing.T) {
+	set := newVersionSet(t)
+	if set.Len() != 6 {
+		t.Fatalf("expected 6 unique versions, got %d", set.Len())
+	}
+	if versions := originals(set.Versions()); versions != "0.9.3 1.0.0 1.2.0+build.1 1.10.0 2.0.0-rc.1 3.0.0-beta" {
+		t.Fatalf("unexpected versions `%s`", versions)
+	}
+	for _, version := range []string{"1.2.0", "1.2.0+other", "2.0.0-rc.1"} {
+		if !set.Contains(version) {
+			t.Fatalf("expected the set to contain `%s`", version)
+		}
+	}
+	for _, version := range []string{"2.0.0", "1.2.1", invalidVersions[0]} {
+		if set.Contains(version) {
+			t.Fatalf("expected the set to not contain `%s`", version)
+		}
+	}
+}
+
+func TestVersionSetLatest(t *testing.T) {
+	set := newVersionSet(t)
+	latest, ok := set.LatestStable()
+	if !ok || latest.Original() != "1.10.0" {
+		t.Fatalf("expected `1.10.0` to be the latest stable, got %v", latest)
+	}
+	if perMajor := originals(set.LatestPerMajor()); perMajor != "0.9.3 1.10.0" {
+		t.Fatalf("unexpected latest per major `%s`", perMajor)
+	}
+
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	empty := semver.NewVersionSet(s)
+	if _, ok := empty.LatestStable(); ok {
+		t.Fatal("expected no latest stable in an empty set")
+	}
+	if err := empty.Add("1.0.0", invalidVersions[0]); err == nil {
+		t.Fatal("expected an error adding an invalid version")
+	}
+	if empty.Len() != 0 {
+		t.Fatal("expected nothing to be added when a version is invalid")
+	}
+}
+
+func TestVersionSetYanked(t *testing.T) {
+	set := newVersionSet(t)
+	for _, version := range []string{"1.10.0+build.1", "3.0.0-beta", "0.9.3"} {
+		if err := set.MarkYanked(version); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := set.MarkYanked("1.3.0"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+	if !set.Yanked("1.10.0") || set.Yanked("1.2.0") || set.Yanked(invalidVersions[0]) {
+		t.Fatal("expected only the marked versions to be yanked")
+	}
+	if latest, ok := set.LatestStable(); !ok || latest.Original() != "1.2.0+build.1" {
+		t.Fatalf("expected `1.2.0+build.1` to be the latest stable, got %v", latest)
+	}
+	if perMajor := originals(set.LatestPerMajor()); perMajor != "1.2.0+build.1" {
+		t.Fatalf("unexpected latest per major `%s`", perMajor)
+	}
+	if max, ok := set.Max(); !ok || max.Original() != "2.0.0-rc.1" {
+		t.Fatalf("expected `2.0.0-rc.1` to be the max, got %v", max)
+	}
+	s := semver.MustNew()
+	cases := []struct {
+		constraint string
+		expected   string
+	}{
+		{"^1.0.0", "1.2.0+build.1"},
+		{"=1.10.0", "1.10.0"},
+		{"=1.10.0 || ^1.0.0", "1.10.0"},
+		{">=1.10.0 <=1.10.0", ""},
+		{"^0.9.0", ""},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.constraint, func(t2 *testing.T) {
+			constraint, err := s.ParseConstraint(c.constraint)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			selected, err := set.Select(constraint)
+			if c.expected == "" {
+				if !errors.IsNotFound(err) {
+					t2.Fatalf("expected a NotFound error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if selected.Original() != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, selected.Original())
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// bound is a lower or upper bound of a constraint group, with the comparator it comes from.
+type bound struct {
+	version   *Version
+	inclusive bool
+	source    *Comparator
+}
+
+// Simplify returns the constraint in a normalized minimal form, for display and as cache key. Within a
+// group the comparators are merged into the tightest bounds, so `>=1.0.0 >=1.2.0` becomes `>=1.2.0`,
+// and exclusions outside of the bounds are dropped. Groups that can't be satisfied, like
+// `>2.0.0 <1.0.0`, are dropped and so are duplicate groups. It fails when no group can be satisfied.
+func (c *Constraint) Simplify() (*Constraint, error) {
+	simplified := &Constraint{semver: c.semver}
+	seen := map[string]bool{}
+	for k := range c.groups {
+		group, ok := simplifyGroup(c.groups[k])
+		if !ok {
+			continue
+		}
+		if len(group) == 1 && group[0].Operator == OperatorAny {
+			simplified.groups = [][]*Comparator{group}
+			return simplified, nil
+		}
+		key := (&Constraint{groups: [][]*Comparator{group}}).String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		simplified.groups = append(simplified.groups, group)
+	}
+	if len(simplified.groups) == 0 {
+		return nil, errors.Errorf("constraint `%s` can't be satisfied", c)
+	}
+	return simplified, nil
+}
+
+// simplifyGroup merges the comparators of the group. It returns false when the group can't be satisfied.
+func simplifyGroup(group []*Comparator) ([]*Comparator, bool) {
+	lower, upper, excluded, ok := groupBounds(group)
+	if !ok {
+		return nil, false
+	}
+	exact := lower != nil && upper != nil && lower.version.Compare(upper.version) == 0
+
+	var exclusions []*Comparator
+	for _, comparator := range excluded {
+		if !withinBounds(comparator.Version, lower, upper) || containsVersion(exclusions, comparator.Version) {
+			continue
+		}
+		if exact {
+			return nil, false
+		}
+		exclusions = append(exclusions, comparator)
+	}
+
+	if exact {
+		return []*Comparator{{Operator: OperatorEqual, Version: lower.version}}, true
+	}
+	var simplified []*Comparator
+	if lower != nil && upper != nil && lower.source == upper.source {
+		simplified = append(simplified, lower.source)
+	} else {
+		if lower != nil {
+			operator := OperatorGreaterThan
+			if lower.inclusive {
+				operator = OperatorGreaterThanOrEqual
+			}
+			simplified = append(simplified, &Comparator{Operator: operator, Version: lower.version})
+		}
+		if upper != nil {
+			operator := OperatorLessThan
+			if upper.inclusive {
+				operator = OperatorLessThanOrEqual
+			}
+			simplified = append(simplified, &Comparator{Operator: operator, Version: upper.version})
+		}
+	}
+	simplified = append(simplified, exclusions...)
+	if len(simplified) == 0 {
+		return []*Comparator{{Operator: OperatorAny}}, true
+	}
+	return simplified, true
+}
+
+// groupBounds merges the comparators of the group into its tightest bounds, returning the exclusions
+// separately. It returns false when the bounds can't be satisfied.
+func groupBounds(group []*Comparator) (*bound, *bound, []*Comparator, bool) {
+	var lower, upper *bound
+	var excluded []*Comparator
+	for _, comparator := range group {
+		switch comparator.Operator {
+		case OperatorEqual:
+			lower = tightenLower(lower, &bound{version: comparator.Version, inclusive: true, source: comparator})
+			upper = tightenUpper(upper, &bound{version: comparator.Version, inclusive: true, source: comparator})
+		case OperatorNotEqual:
+			excluded = append(excluded, comparator)
+		case OperatorGreaterThan, OperatorGreaterThanOrEqual:
+			lower = tightenLower(lower, &bound{version: comparator.Version,
+				inclusive: comparator.Operator == OperatorGreaterThanOrEqual, source: comparator})
+		case OperatorLessThan, OperatorLessThanOrEqual:
+			upper = tightenUpper(upper, &bound{version: comparator.Version,
+				inclusive: comparator.Operator == OperatorLessThanOrEqual, source: comparator})
+		case OperatorTilde, OperatorCaret:
+			lower = tightenLower(lower, &bound{version: comparator.Version, inclusive: true, source: comparator})
+			if next := comparator.upper(); next != nil {
+				upper = tightenUpper(upper, &bound{version: next, source: comparator})
+			}
+		}
+	}
+	if lower != nil && upper != nil {
+		c := lower.version.Compare(upper.version)
+		if c > 0 || (c == 0 && !(lower.inclusive && upper.inclusive)) {
+			return nil, nil, nil, false
+		}
+	}
+	return lower, upper, excluded, true
+}
+
+func tightenLower(current *bound, candidate *bound) *bound {
+	if current == nil {
+		return candidate
+	}
+	c := candidate.version.Compare(current.version)
+	if c > 0 || (c == 0 && current.inclusive && !candidate.inclusive) {
+		return candidate
+	}
+	return current
+}
+
+func tightenUpper(current *bound, candidate *bound) *bound {
+	if current == nil {
+		return candidate
+	}
+	c := candidate.version.Compare(current.version)
+	if c < 0 || (c == 0 && current.inclusive && !candidate.inclusive) {
+		return candidate
+	}
+	return current
+}
+
+func withinBounds(v *Version, lower *bound, upper *bound) bool {
+	if lower != nil {
+		if c := v.Compare(lower.version); c < 0 || (c == 0 && !lower.inclusive) {
+			return false
+		}
+	}
+	if upper != nil {
+		if c := v.Compare(upper.version); c > 0 || (c == 0 && !upper.inclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsVersion(comparators []*Comparator, v *Version) bool {
+	for k := range comparators {
+		if comparators[k].Version.Compare(v) == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestConstraintSimplify(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		constraint string
+		expected   string
+	}{
+		{">=1.0.0 >=1.2.0", ">=1.2.0"},
+		{">1.0.0 >=1.0.0 <3.0.0 <=2.0.0", ">1.0.0 <=2.0.0"},
+		{"<2.0.0 <=2.0.0", "<2.0.0"},
+		{">=1.0.0 <=1.0.0", "1.0.0"},
+		{"1.2.3 >=1.0.0 ^1.2.0", "1.2.3"},
+		{"^1.2.0", "^1.2.0"},
+		{"^1.2.0 >=1.4.0", ">=1.4.0 <2.0.0-0"},
+		{"~1.2.0 <1.2.5", ">=1.2.0 <1.2.5"},
+		{">=1.0.0 !=0.9.0 !=1.5.0 !=1.5.0+build", ">=1.0.0 !=1.5.0"},
+		{"* >=1.0.0", ">=1.0.0"},
+		{"* *", "*"},
+		{">=1.0.0 || * || <2.0.0", "*"},
+		{">=1.0.0 >=1.2.0 || >=1.2.0", ">=1.2.0"},
+		{">2.0.0 <1.0.0 || ^3.0.0", "^3.0.0"},
+		{"!=1.0.0 1.0.0 || 2.0.0", "2.0.0"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.constraint, func(t2 *testing.T) {
+			constraint, err := s.ParseConstraint(c.constraint)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			simplified, err := constraint.Simplify()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if result := simplified.String(); result != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, result)
+			}
+			for _, version := range []string{"0.9.0", "1.0.0", "1.2.0", "1.2.3", "1.2.4", "1.4.0", "1.5.0", "2.0.0",
+				"2.0.0-rc.1", "2.1.0", "3.1.0"} {
+				v := s.MustParse(version)
+				if constraint.CheckVersion(v) != simplified.CheckVersion(v) {
+					t2.Fatalf("expected `%s` to be matched the same after simplifying", version)
+				}
+			}
+		})
+	}
+}
+
+func TestConstraintSimplifyImpossible(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expression := range []string{">2.0.0 <1.0.0", ">1.0.0 <1.0.0", ">=1.0.0 <1.0.0", "1.0.0 !=1.0.0", "1.0.0 2.0.0",
+		"^1.2.0 >=2.0.0"} {
+		constraint, err := s.ParseConstraint(expression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := constraint.Simplify(); err == nil {
+			t.Fatalf("expected `%s` to be impossible", expression)
+		}
+	}
+}
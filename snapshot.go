@@ -0,0 +1,243 @@
+package semver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/juju/errors"
+)
+
+const maxInt = int(^uint(0) >> 1)
+
+var snapshotMagic = []byte("SVS1")
+
+// Snapshot is a compact, read-only and sorted set of versions that can be shipped around
+// as bytes and loaded quickly for membership checks.
+//
+// The encoding stores every version as the delta to its predecessor. When the major changes the
+// minor and patch are stored as is, same for the patch when only the minor changes. Prerelease
+// tags and build metadata are stored once in a dictionary and referenced by index.
+type Snapshot struct {
+	semver     *Semver
+	entries    []snapshotEntry
+	dictionary []string
+}
+
+type snapshotEntry struct {
+	major int
+	minor int
+	patch int
+	tag   int
+	build int
+}
+
+// EncodeSnapshot sorts and deduplicates the versions and encodes them into a snapshot.
+func (s *Semver) EncodeSnapshot(versions []string) ([]byte, error) {
+	parsed := make([]*Version, len(versions))
+	for k := range versions {
+		v, err := s.Parse(versions[k])
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if v.Epoch != 0 {
+			return nil, errors.NotSupportedf("version `%s` with an epoch in a snapshot", versions[k])
+		}
+		if v.Revision != 0 {
+			return nil, errors.NotSupportedf("version `%s` with a revision in a snapshot", versions[k])
+		}
+		parsed[k] = v
+	}
+	sort.Slice(parsed, func(i, j int) bool {
+		return compareSnapshotVersions(parsed[i], parsed[j]) < 0
+	})
+
+	dictionary := map[string]int{"": 0}
+	words := []string{""}
+	index := func(word string) int {
+		i, ok := dictionary[word]
+		if !ok {
+			i = len(words)
+			dictionary[word] = i
+			words = append(words, word)
+		}
+		return i
+	}
+	entries := make([]snapshotEntry, 0, len(parsed))
+	for k := range parsed {
+		if k > 0 && compareSnapshotVersions(parsed[k-1], parsed[k]) == 0 {
+			continue
+		}
+		entries = append(entries, snapshotEntry{
+			major: parsed[k].Major,
+			minor: parsed[k].Minor,
+			patch: parsed[k].Patch,
+			tag:   index(parsed[k].Prerelease),
+			build: index(parsed[k].Build),
+		})
+	}
+
+	buf := bytes.NewBuffer(append([]byte{}, snapshotMagic...))
+	scratch := make([]byte, binary.MaxVarintLen64)
+	writeUvarint := func(n int) {
+		buf.Write(scratch[:binary.PutUvarint(scratch, uint64(n))])
+	}
+	writeUvarint(len(words))
+	for k := range words {
+		writeUvarint(len(words[k]))
+		buf.WriteString(words[k])
+	}
+	writeUvarint(len(entries))
+	var previous snapshotEntry
+	for k := range entries {
+		e := entries[k]
+		switch {
+		case e.major != previous.major:
+			writeUvarint(e.major - previous.major)
+			writeUvarint(e.minor)
+			writeUvarint(e.patch)
+		case e.minor != previous.minor:
+			writeUvarint(0)
+			writeUvarint(e.minor - previous.minor)
+			writeUvarint(e.patch)
+		default:
+			writeUvarint(0)
+			writeUvarint(0)
+			writeUvarint(e.patch - previous.patch)
+		}
+		writeUvarint(e.tag)
+		writeUvarint(e.build)
+		previous = e
+	}
+	return buf.Bytes(), nil
+}
+
+// LoadSnapshot decodes a snapshot created by EncodeSnapshot.
+func (s *Semver) LoadSnapshot(data []byte) (*Snapshot, error) {
+	if !bytes.HasPrefix(data, snapshotMagic) {
+		return nil, errors.New("data is not a version snapshot")
+	}
+	data = data[len(snapshotMagic):]
+	readUvarint := func() (int, error) {
+		n, size := binary.Uvarint(data)
+		if size <= 0 || n > uint64(maxInt) {
+			return 0, errors.New("snapshot is corrupt")
+		}
+		data = data[size:]
+		return int(n), nil
+	}
+
+	snapshot := &Snapshot{semver: s}
+	words, err := readUvarint()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if words > len(data) {
+		return nil, errors.New("snapshot is corrupt")
+	}
+	snapshot.dictionary = make([]string, words)
+	for k := range snapshot.dictionary {
+		length, err := readUvarint()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if length > len(data) {
+			return nil, errors.New("snapshot is corrupt")
+		}
+		snapshot.dictionary[k] = string(data[:length])
+		data = data[length:]
+	}
+
+	count, err := readUvarint()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if count > len(data) {
+		return nil, errors.New("snapshot is corrupt")
+	}
+	snapshot.entries = make([]snapshotEntry, count)
+	var previous snapshotEntry
+	for k := range snapshot.entries {
+		var fields [5]int
+		for i := range fields {
+			if fields[i], err = readUvarint(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		e := snapshotEntry{major: previous.major + fields[0], tag: fields[3], build: fields[4]}
+		switch {
+		case fields[0] != 0:
+			e.minor = fields[1]
+			e.patch = fields[2]
+		case fields[1] != 0:
+			e.minor = previous.minor + fields[1]
+			e.patch = fields[2]
+		default:
+			e.minor = previous.minor
+			e.patch = previous.patch + fields[2]
+		}
+		if e.major < previous.major || e.minor < 0 || e.patch < 0 || e.tag >= words || e.build >= words {
+			return nil, errors.New("snapshot is corrupt")
+		}
+		snapshot.entries[k] = e
+		previous = e
+	}
+	if len(data) != 0 {
+		return nil, errors.New("snapshot has trailing data")
+	}
+	return snapshot, nil
+}
+
+// Len returns the number of versions in the snapshot.
+func (s *Snapshot) Len() int {
+	return len(s.entries)
+}
+
+// Versions returns all versions in the snapshot in ascending order.
+func (s *Snapshot) Versions() []*Version {
+	versions := make([]*Version, len(s.entries))
+	for k := range s.entries {
+		versions[k] = s.version(k)
+	}
+	return versions
+}
+
+// Contains checks if the exact version, including its build metadata, is in the snapshot.
+func (s *Snapshot) Contains(version string) bool {
+	v, err := s.semver.Parse(version)
+	if err != nil {
+		return false
+	}
+	i := sort.Search(len(s.entries), func(i int) bool {
+		return compareSnapshotVersions(s.version(i), v) >= 0
+	})
+	return i < len(s.entries) && compareSnapshotVersions(s.version(i), v) == 0
+}
+
+func (s *Snapshot) version(i int) *Version {
+	e := s.entries[i]
+	v := &Version{
+		Major:      e.major,
+		Minor:      e.minor,
+		Patch:      e.patch,
+		Prerelease: s.dictionary[e.tag],
+		Build:      s.dictionary[e.build],
+	}
+	v.original = v.canonical()
+	return v
+}
+
+// compareSnapshotVersions orders by precedence and then by build metadata, so versions that only
+// differ in their build metadata still have a stable order.
+func compareSnapshotVersions(a *Version, b *Version) int {
+	if c := a.Compare(b); c != 0 {
+		return c
+	}
+	if a.Build < b.Build {
+		return -1
+	}
+	if a.Build > b.Build {
+		return 1
+	}
+	return 0
+}
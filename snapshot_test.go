@@ -0,0 +1,72 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	versions := []string{
+		"2.0.0", "1.0.0", "1.0.1", "1.0.1", "1.0.10", "1.2.0-rc.1", "1.2.0-rc.2",
+		"1.2.0", "1.2.0+build.1", "0.9.0", "10.0.0-rc.1",
+	}
+	data, err := semver.EncodeSnapshot(versions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot, err := semver.LoadSnapshot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"0.9.0", "1.0.0", "1.0.1", "1.0.10", "1.2.0-rc.1", "1.2.0-rc.2",
+		"1.2.0", "1.2.0+build.1", "2.0.0", "10.0.0-rc.1",
+	}
+	loaded := snapshot.Versions()
+	if snapshot.Len() != len(expected) || len(loaded) != len(expected) {
+		t.Fatalf("expected %d versions, got %d", len(expected), snapshot.Len())
+	}
+	for k := range expected {
+		if loaded[k].Original() != expected[k] {
+			t.Fatalf("expected `%s` at %d, got `%s`", expected[k], k, loaded[k].Original())
+		}
+		if !snapshot.Contains(expected[k]) {
+			t.Fatalf("expected the snapshot to contain `%s`", expected[k])
+		}
+	}
+	for _, version := range []string{"1.0.2", "1.2.0+build.2", "1.2.0-rc.3", "3.0.0", invalidVersions[0]} {
+		if snapshot.Contains(version) {
+			t.Fatalf("expected the snapshot to not contain `%s`", version)
+		}
+	}
+}
+
+func TestSnapshotCorrupt(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := semver.EncodeSnapshot([]string{"1.0.0", "1.1.0-beta", "2.0.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.LoadSnapshot([]byte("nope")); err == nil {
+		t.Fatal("expected an error for data without the snapshot header")
+	}
+	for k := len(data) - 1; k > 4; k-- {
+		if _, err := semver.LoadSnapshot(data[:k]); err == nil {
+			t.Fatalf("expected an error for data truncated at %d", k)
+		}
+	}
+	if _, err := semver.LoadSnapshot(append(data, 0)); err == nil {
+		t.Fatal("expected an error for trailing data")
+	}
+	if _, err := semver.EncodeSnapshot([]string{invalidVersions[0]}); err == nil {
+		t.Fatal("expected an error encoding an invalid version")
+	}
+}
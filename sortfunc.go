@@ -0,0 +1,47 @@
+package semver
+
+import (
+	"strings"
+)
+
+// CompareFunc returns a comparison of version strings for slices.SortFunc and the like, by the semver
+// 2.0.0 spec without options. See Semver.CompareFunc.
+func CompareFunc() func(a string, b string) int {
+	return defaultSemver.CompareFunc()
+}
+
+// CompareFunc returns a comparison of version strings for slices.SortFunc and the like, which orders by
+// precedence. It's a total order, so invalid versions are ordered before the valid ones, by their bytes.
+// Pass it a field to sort structs by their version, like:
+//
+//	compare := s.CompareFunc()
+//	slices.SortFunc(releases, func(a, b Release) int { return compare(a.Version, b.Version) })
+func (s *Semver) CompareFunc() func(a string, b string) int {
+	return func(a string, b string) int {
+		va, errA := s.parseInput(a)
+		vb, errB := s.parseInput(b)
+		switch {
+		case errA != nil && errB != nil:
+			return strings.Compare(a, b)
+		case errA != nil:
+			return -1
+		case errB != nil:
+			return 1
+		}
+		return va.Compare(vb)
+	}
+}
+
+// CompareVersions compares a to b by precedence like Version.Compare, shaped for slices.SortFunc.
+// A nil version is ordered before any other.
+func CompareVersions(a *Version, b *Version) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	return a.Compare(b)
+}
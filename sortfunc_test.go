@@ -0,0 +1,48 @@
+package semver_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestCompareFunc(t *testing.T) {
+	versions := []string{"1.10.0", "invalid", "1.2.0", "1.2.0-rc.1", "0.9.0", "1.2", "2.0.0+build.1"}
+	slices.SortFunc(versions, semver.CompareFunc())
+	expected := []string{"1.2", "invalid", "0.9.0", "1.2.0-rc.1", "1.2.0", "1.10.0", "2.0.0+build.1"}
+	if !reflect.DeepEqual(versions, expected) {
+		t.Fatalf("expected %v, got %v", expected, versions)
+	}
+
+	s := semver.MustNew(semver.WithEpoch())
+	versions = []string{"1:0.1.0", "2.0.0"}
+	slices.SortFunc(versions, s.CompareFunc())
+	if versions[0] != "2.0.0" {
+		t.Fatalf("expected the epoch to sort last, got %v", versions)
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	type release struct {
+		name    string
+		version *semver.Version
+	}
+	releases := []release{
+		{"c", semver.MustParse("2.0.0")},
+		{"a", nil},
+		{"b", semver.MustParse("1.0.0")},
+		{"d", semver.MustParse("1.0.0-beta")},
+	}
+	slices.SortFunc(releases, func(a, b release) int {
+		return semver.CompareVersions(a.version, b.version)
+	})
+	var names string
+	for _, r := range releases {
+		names += r.name
+	}
+	if names != "adbc" {
+		t.Fatalf("expected `adbc`, got `%s`", names)
+	}
+}
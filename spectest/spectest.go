@@ -0,0 +1,117 @@
+// Package spectest holds the semver.org regex test corpus and checks validators against it, so an
+// instance configured with lenient options can be asserted to still follow the spec.
+package spectest
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Valid are the versions of the semver.org corpus that are valid by the spec.
+var Valid = []string{
+	"0.0.4",
+	"1.2.3",
+	"10.20.30",
+	"1.1.2-prerelease+meta",
+	"1.1.2+meta",
+	"1.1.2+meta-valid",
+	"1.0.0-alpha",
+	"1.0.0-beta",
+	"1.0.0-alpha.beta",
+	"1.0.0-alpha.beta.1",
+	"1.0.0-alpha.1",
+	"1.0.0-alpha0.valid",
+	"1.0.0-alpha.0valid",
+	"1.0.0-alpha-a.b-c-somethinglong+build.1-aef.1-its-okay",
+	"1.0.0-rc.1+build.1",
+	"2.0.0-rc.1+build.123",
+	"1.2.3-beta",
+	"10.2.3-DEV-SNAPSHOT",
+	"1.2.3-SNAPSHOT-123",
+	"1.0.0",
+	"2.0.0",
+	"1.1.7",
+	"2.0.0+build.1848",
+	"2.0.1-alpha.1227",
+	"1.0.0-alpha+beta",
+	"1.2.3----RC-SNAPSHOT.12.9.1--.12+788",
+	"1.2.3----R-S.12.9.1--.12+meta",
+	"1.2.3----RC-SNAPSHOT.12.9.1--.12",
+	"1.0.0+0.build.1-rc.10000aaa-kk-0.1",
+	"1.0.0-0A.is.legal",
+}
+
+// Large are the versions of the semver.org corpus that are valid by the spec, but have parts too big
+// to fit in an int. Validators of this module reject them, so Conformance doesn't check them.
+var Large = []string{
+	"99999999999999999999999.999999999999999999.99999999999999999",
+}
+
+// Invalid are the versions of the semver.org corpus that are invalid by the spec.
+var Invalid = []string{
+	"1",
+	"1.2",
+	"1.2.3-0123",
+	"1.2.3-0123.0123",
+	"1.1.2+.123",
+	"+invalid",
+	"-invalid",
+	"-invalid+invalid",
+	"-invalid.01",
+	"alpha",
+	"alpha.beta",
+	"alpha.beta.1",
+	"alpha.1",
+	"alpha+beta",
+	"alpha_beta",
+	"alpha.",
+	"alpha..",
+	"beta",
+	"1.0.0-alpha_beta",
+	"-alpha.",
+	"1.0.0-alpha..",
+	"1.0.0-alpha..1",
+	"1.0.0-alpha...1",
+	"1.0.0-alpha....1",
+	"1.0.0-alpha.....1",
+	"1.0.0-alpha......1",
+	"1.0.0-alpha.......1",
+	"01.1.1",
+	"1.01.1",
+	"1.1.01",
+	"1.2.3.DEV",
+	"1.2-SNAPSHOT",
+	"1.2.31.2.3----RC-SNAPSHOT.12.09.1--..12+788",
+	"1.2-RC-SNAPSHOT",
+	"-1.0.3-gamma+b7718",
+	"+justmeta",
+	"9.8.7+meta+meta",
+	"9.8.7-whatever+meta+meta",
+	"99999999999999999999999.999999999999999999.99999999999999999----RC-SNAPSHOT.12.09.1--------------------------------..12",
+}
+
+// Validator is satisfied by *semver.Semver and any other semver.Versioning.
+type Validator interface {
+	Valid(version string) bool
+}
+
+// Conformance checks the validator against the corpus. It returns an error listing every valid
+// version that's rejected and every invalid version that's accepted.
+func Conformance(validator Validator) error {
+	var failures []string
+	for k := range Valid {
+		if !validator.Valid(Valid[k]) {
+			failures = append(failures, "`"+Valid[k]+"` should be valid")
+		}
+	}
+	for k := range Invalid {
+		if validator.Valid(Invalid[k]) {
+			failures = append(failures, "`"+Invalid[k]+"` should be invalid")
+		}
+	}
+	if len(failures) > 0 {
+		return errors.Errorf("validator doesn't conform to the spec: %s", strings.Join(failures, ", "))
+	}
+	return nil
+}
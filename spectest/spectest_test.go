@@ -0,0 +1,62 @@
+package spectest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/espal-digital-development/semver/spectest"
+)
+
+type lenient struct{}
+
+func (lenient) Valid(version string) bool {
+	return version == "1.2" || strings.Count(version, ".") == 2 && !strings.HasPrefix(version, "0")
+}
+
+func TestConformance(t *testing.T) {
+	cases := []struct {
+		name    string
+		options []semver.Option
+	}{
+		{"default", nil},
+		{"epoch", []semver.Option{semver.WithEpoch()}},
+		{"channels", []semver.Option{semver.WithChannelOrder("alpha", "beta", "rc")}},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			s, err := semver.New(c.options...)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if err := spectest.Conformance(s); err != nil {
+				t2.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestConformanceFailures(t *testing.T) {
+	err := spectest.Conformance(lenient{})
+	if err == nil {
+		t.Fatal("expected an error for a lenient validator")
+	}
+	for _, expected := range []string{"`1.2` should be invalid", "`0.0.4` should be valid"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected the error to contain %s, got %v", expected, err)
+		}
+	}
+}
+
+func TestLarge(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, version := range spectest.Large {
+		if s.Valid(version) {
+			t.Fatalf("expected `%s` to be rejected for not fitting in an int", version)
+		}
+	}
+}
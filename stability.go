@@ -0,0 +1,94 @@
+package semver
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// StabilityLevel is how stable a version is, by its prerelease. The levels are ordered from the riskiest
+// to the most stable, so tooling can filter versions with a minimum level like `>= StabilityBeta`.
+type StabilityLevel int
+
+// The stability levels from riskiest to most stable.
+const (
+	StabilityDev StabilityLevel = iota
+	StabilityAlpha
+	StabilityBeta
+	StabilityReleaseCandidate
+	StabilityStable
+)
+
+var stabilityNames = []string{"dev", "alpha", "beta", "rc", "stable"}
+
+func (l StabilityLevel) String() string {
+	if l < StabilityDev || l > StabilityStable {
+		return "unknown"
+	}
+	return stabilityNames[l]
+}
+
+// defaultStabilities maps the common prerelease identifiers to their stability level.
+var defaultStabilities = map[string]StabilityLevel{
+	"dev":      StabilityDev,
+	"snapshot": StabilityDev,
+	"nightly":  StabilityDev,
+	"canary":   StabilityDev,
+	"alpha":    StabilityAlpha,
+	"a":        StabilityAlpha,
+	"beta":     StabilityBeta,
+	"b":        StabilityBeta,
+	"preview":  StabilityBeta,
+	"rc":       StabilityReleaseCandidate,
+	"cr":       StabilityReleaseCandidate,
+	"pre":      StabilityReleaseCandidate,
+}
+
+// WithStabilityMapping replaces the mapping of prerelease identifiers to stability levels that Stability
+// classifies by. Identifiers are matched case-insensitively. By default `dev`, `snapshot`, `nightly` and
+// `canary` are StabilityDev, `alpha` and `a` StabilityAlpha, `beta`, `b` and `preview` StabilityBeta and
+// `rc`, `cr` and `pre` StabilityReleaseCandidate.
+func WithStabilityMapping(mapping map[string]StabilityLevel) Option {
+	return func(s *Semver) error {
+		stabilities := make(map[string]StabilityLevel, len(mapping))
+		for identifier, level := range mapping {
+			if !ValidPrereleaseIdentifier(identifier) || isNumeric(identifier) {
+				return errors.Errorf("prerelease identifier `%s` is invalid", identifier)
+			}
+			if level < StabilityDev || level > StabilityStable {
+				return errors.Errorf("stability level %d of `%s` is invalid", level, identifier)
+			}
+			stabilities[strings.ToLower(identifier)] = level
+		}
+		s.stabilities = stabilities
+		return nil
+	}
+}
+
+// Stability classifies the version by its prerelease. Releases are StabilityStable. For prereleases the
+// first identifier in the mapping decides, where a trailing number is ignored, so `1.0.0-rc.1` and
+// `1.0.0-RC1` are both StabilityReleaseCandidate. Prereleases without a known identifier are
+// StabilityDev, as the riskiest level.
+func (s *Semver) Stability(version string) (StabilityLevel, error) {
+	v, err := s.Parse(version)
+	if err != nil {
+		return StabilityDev, errors.Trace(err)
+	}
+	if v.Prerelease == "" {
+		return StabilityStable, nil
+	}
+	stabilities := s.stabilities
+	if stabilities == nil {
+		stabilities = defaultStabilities
+	}
+	for _, identifier := range strings.Split(strings.ToLower(v.Prerelease), ".") {
+		if level, ok := stabilities[identifier]; ok {
+			return level, nil
+		}
+		trimmed := strings.TrimRight(strings.TrimRight(identifier, "0123456789"), "-")
+		if level, ok := stabilities[trimmed]; ok {
+			return level, nil
+		}
+	}
+	return StabilityDev, nil
+}
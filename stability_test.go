@@ -0,0 +1,81 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestStability(t *testing.T) {
+	s := semver.MustNew()
+	tests := []struct {
+		version  string
+		expected semver.StabilityLevel
+	}{
+		{"1.2.3", semver.StabilityStable},
+		{"1.2.3+build.5", semver.StabilityStable},
+		{"1.2.3-rc.1", semver.StabilityReleaseCandidate},
+		{"1.2.3-RC1", semver.StabilityReleaseCandidate},
+		{"1.2.3-beta", semver.StabilityBeta},
+		{"1.2.3-beta-2", semver.StabilityBeta},
+		{"1.2.3-alpha.1", semver.StabilityAlpha},
+		{"1.2.3-0.alpha", semver.StabilityAlpha},
+		{"1.2.3-nightly.20240101", semver.StabilityDev},
+		{"1.2.3-feature.x", semver.StabilityDev},
+		{"1.2.3-0", semver.StabilityDev},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t2 *testing.T) {
+			level, err := s.Stability(test.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if level != test.expected {
+				t2.Fatalf("expected %s, got %s", test.expected, level)
+			}
+		})
+	}
+	if _, err := s.Stability("1.2"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if semver.StabilityReleaseCandidate.String() != "rc" || semver.StabilityLevel(9).String() != "unknown" {
+		t.Fatal("expected the names of the levels")
+	}
+}
+
+func TestWithStabilityMapping(t *testing.T) {
+	s := semver.MustNew(semver.WithStabilityMapping(map[string]semver.StabilityLevel{
+		"Milestone": semver.StabilityBeta,
+		"ga":        semver.StabilityStable,
+	}))
+	tests := []struct {
+		version  string
+		expected semver.StabilityLevel
+	}{
+		{"1.0.0-milestone.2", semver.StabilityBeta},
+		{"1.0.0-ga", semver.StabilityStable},
+		{"1.0.0-rc.1", semver.StabilityDev},
+	}
+	for _, test := range tests {
+		t.Run(test.version, func(t2 *testing.T) {
+			level, err := s.Stability(test.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if level != test.expected {
+				t2.Fatalf("expected %s, got %s", test.expected, level)
+			}
+		})
+	}
+
+	invalid := []map[string]semver.StabilityLevel{
+		{"r c": semver.StabilityBeta},
+		{"1": semver.StabilityBeta},
+		{"rc": semver.StabilityLevel(7)},
+	}
+	for k := range invalid {
+		if _, err := semver.New(semver.WithStabilityMapping(invalid[k])); err == nil {
+			t.Fatalf("expected an error for mapping %v", invalid[k])
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package semver
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Result is the outcome of validating a single line of a stream.
+type Result struct {
+	// Line is the 1-based line number the input was read from.
+	Line  int
+	Input string
+	Valid bool
+	// Version is the parsed version when the input is valid.
+	Version *Version
+	// Err explains why the input is invalid, or holds the error that stopped reading the stream.
+	Err error
+}
+
+// ValidateStream reads newline-separated versions from r and emits a Result for every non-empty line.
+// Surrounding whitespace is trimmed. The channel is closed once r is exhausted; a read error is
+// emitted as a final Result without Input. The channel must be drained to release the reader.
+func (s *Semver) ValidateStream(r io.Reader) (<-chan Result, error) {
+	if r == nil {
+		return nil, errors.New("reader cannot be nil")
+	}
+	results := make(chan Result)
+	go func() {
+		defer close(results)
+		scanner := bufio.NewScanner(r)
+		var line int
+		for scanner.Scan() {
+			line++
+			input := strings.TrimSpace(scanner.Text())
+			if input == "" {
+				continue
+			}
+			result := Result{Line: line, Input: input}
+			result.Version, result.Err = s.Parse(input)
+			result.Valid = result.Err == nil
+			results <- result
+		}
+		if err := scanner.Err(); err != nil {
+			results <- Result{Line: line + 1, Err: errors.Trace(err)}
+		}
+	}()
+	return results, nil
+}
@@ -0,0 +1,76 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestValidateStream(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := semver.ValidateStream(strings.NewReader("1.2.3\n\n  2.0\n3.0.0-rc.1\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		line  int
+		input string
+		valid bool
+	}{
+		{1, "1.2.3", true},
+		{3, "2.0", false},
+		{4, "3.0.0-rc.1", true},
+	}
+	var k int
+	for result := range results {
+		if k >= len(expected) {
+			t.Fatalf("unexpected result %+v", result)
+		}
+		e := expected[k]
+		if result.Line != e.line || result.Input != e.input || result.Valid != e.valid {
+			t.Fatalf("expected %+v, got %+v", e, result)
+		}
+		if result.Valid && result.Version == nil {
+			t.Fatal("expected a parsed version for a valid result")
+		}
+		if !result.Valid && result.Err == nil {
+			t.Fatal("expected an error for an invalid result")
+		}
+		k++
+	}
+	if k != len(expected) {
+		t.Fatalf("expected %d results, got %d", len(expected), k)
+	}
+}
+
+func TestValidateStreamErrors(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := semver.ValidateStream(nil); err == nil {
+		t.Fatal("expected an error for a nil reader")
+	}
+	results, err := semver.ValidateStream(failingReader{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, ok := <-results
+	if !ok || result.Err == nil || result.Input != "" {
+		t.Fatalf("expected a read error result, got %+v", result)
+	}
+	if _, ok := <-results; ok {
+		t.Fatal("expected the results to be closed after a read error")
+	}
+}
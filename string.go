@@ -0,0 +1,31 @@
+package semver
+
+import "fmt"
+
+var _ fmt.Stringer = Version{}
+
+// StringOptions configures how StringWithOptions renders a version.
+type StringOptions struct {
+	// IncludeBuild renders the build metadata.
+	IncludeBuild bool
+	// VPrefix prefixes the version with a `v`, like Go modules and git tags commonly do.
+	VPrefix bool
+}
+
+// String implements fmt.Stringer and returns the version in its canonical semver notation,
+// including the build metadata.
+func (v Version) String() string {
+	return v.canonical()
+}
+
+// StringWithOptions returns the version in canonical semver notation, styled by the options.
+func (v Version) StringWithOptions(options StringOptions) string {
+	if !options.IncludeBuild {
+		v.Build = ""
+	}
+	version := v.canonical()
+	if options.VPrefix {
+		version = "v" + version
+	}
+	return version
+}
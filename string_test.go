@@ -0,0 +1,57 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestVersionString(t *testing.T) {
+	cases := []struct {
+		version  string
+		options  semver.StringOptions
+		expected string
+	}{
+		{"1.2.3", semver.StringOptions{}, "1.2.3"},
+		{"1.2.3-rc.1+build.5", semver.StringOptions{}, "1.2.3-rc.1"},
+		{"1.2.3-rc.1+build.5", semver.StringOptions{IncludeBuild: true}, "1.2.3-rc.1+build.5"},
+		{"1.2.3+build.5", semver.StringOptions{VPrefix: true}, "v1.2.3"},
+		{"1.2.3+build.5", semver.StringOptions{IncludeBuild: true, VPrefix: true}, "v1.2.3+build.5"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run("string-"+c.version+"_"+c.expected, func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			v, err := semver.Parse(c.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if v.String() != c.version {
+				t2.Fatalf("expected `%s`, got `%s`", c.version, v.String())
+			}
+			if formatted := v.StringWithOptions(c.options); formatted != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, formatted)
+			}
+		})
+	}
+}
+
+func TestVersionStringer(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := s.Parse("1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	max := semver.Max()
+	if formatted := fmt.Sprintf("%s %v %s", v, *v, max); formatted !=
+		"1.2.3-rc.1+build.5 1.2.3-rc.1+build.5 "+max.Original() {
+		t.Fatalf("unexpected formatting `%s`", formatted)
+	}
+}
@@ -0,0 +1,41 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+)
+
+// IsImmediateSuccessor validates both versions and checks if next is exactly one bump away from prev, so
+// no releases are skipped. From a release that's the patch, minor or major bump, like `1.2.4`, `1.3.0` or
+// `2.0.0` for `1.2.3`, or a prerelease of those. From a prerelease it's its release or a higher
+// prerelease of the same version, so `1.3.0-rc.2` and `1.3.0` succeed `1.3.0-rc.1`, but `1.3.1` doesn't.
+// Build metadata is ignored.
+func (s *Semver) IsImmediateSuccessor(prev string, next string) (bool, error) {
+	p, err := s.Parse(prev)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	n, err := s.Parse(next)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return immediateSuccessor(p, n), nil
+}
+
+func immediateSuccessor(p *Version, n *Version) bool {
+	if n.Compare(p) <= 0 {
+		return false
+	}
+	if p.Prerelease != "" {
+		return n.CompareCore(p) == 0
+	}
+	for bump := BumpPatch; bump <= BumpMajor; bump++ {
+		bumped, err := p.increment(bump)
+		if err != nil {
+			continue
+		}
+		if n.CompareCore(bumped) == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestIsImmediateSuccessor(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		prev      string
+		next      string
+		successor bool
+	}{
+		{"1.2.3", "1.2.4", true},
+		{"1.2.3", "1.3.0", true},
+		{"1.2.3", "2.0.0", true},
+		{"1.2.3", "1.3.0-rc.1", true},
+		{"1.2.3", "1.2.4+build.5", true},
+		{"1.2.3", "1.2.5", false},
+		{"1.2.3", "1.3.1", false},
+		{"1.2.3", "1.4.0", false},
+		{"1.2.3", "2.0.1", false},
+		{"1.2.3", "2.1.0", false},
+		{"1.2.3", "1.2.3", false},
+		{"1.2.3", "1.2.2", false},
+		{"1.2.3", "1.2.3+build.5", false},
+		{"1.3.0-rc.1", "1.3.0-rc.2", true},
+		{"1.3.0-rc.1", "1.3.0", true},
+		{"1.3.0-rc.2", "1.3.0-rc.1", false},
+		{"1.3.0-rc.1", "1.3.1", false},
+		{"1.3.0-rc.1", "1.4.0", false},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.prev+" "+c.next, func(t2 *testing.T) {
+			successor, err := s.IsImmediateSuccessor(c.prev, c.next)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if successor != c.successor {
+				t2.Fatalf("expected %t, got %t", c.successor, successor)
+			}
+		})
+	}
+}
+
+func TestIsImmediateSuccessorErrors(t *testing.T) {
+	s := semver.MustNew()
+	if _, err := s.IsImmediateSuccessor("1.2", "1.2.1"); err == nil {
+		t.Fatal("expected an error for an invalid prev")
+	}
+	if _, err := s.IsImmediateSuccessor("1.2.0", "1.3"); err == nil {
+		t.Fatal("expected an error for an invalid next")
+	}
+}
@@ -0,0 +1,80 @@
+package semver
+
+import (
+	"text/template"
+
+	"github.com/juju/errors"
+)
+
+// FuncMap returns the template functions for version logic in templates, like generated manifests
+// and docs. The version is the last argument, so the functions can be used in pipelines like
+// `{{ .Version | semverBump "minor" }}`:
+//
+//	semverValid version                          bool
+//	semverBump "major"|"minor"|"patch" version   the bumped version
+//	semverSatisfies constraint version           bool
+//	semverCompare a b                            -1, 0 or 1
+//	semverMajor|semverMinor|semverPatch version  int
+//	semverPrerelease version                     the prerelease, or an empty string
+//	semverPromote version                        the release of a prerelease
+//
+// Invalid input fails the template execution. The map can be converted to an html/template.FuncMap.
+func (s *Semver) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"semverValid": s.Valid,
+		"semverBump": func(bump string, version string) (string, error) {
+			for k := BumpPatch; k <= BumpMajor; k++ {
+				if bump == k.String() {
+					v, err := s.Parse(version)
+					if err != nil {
+						return "", errors.Trace(err)
+					}
+					bumped, err := v.Bump(k)
+					if err != nil {
+						return "", errors.Trace(err)
+					}
+					return bumped.canonical(), nil
+				}
+			}
+			return "", errors.Errorf("bump `%s` should be `major`, `minor` or `patch`", bump)
+		},
+		"semverSatisfies": func(constraint string, version string) (bool, error) {
+			c, err := s.ParseConstraint(constraint)
+			if err != nil {
+				return false, errors.Trace(err)
+			}
+			ok, err := c.Check(version)
+			return ok, errors.Trace(err)
+		},
+		"semverCompare": s.Compare,
+		"semverMajor": func(version string) (int, error) {
+			v, err := s.Parse(version)
+			if err != nil {
+				return 0, errors.Trace(err)
+			}
+			return v.Major, nil
+		},
+		"semverMinor": func(version string) (int, error) {
+			v, err := s.Parse(version)
+			if err != nil {
+				return 0, errors.Trace(err)
+			}
+			return v.Minor, nil
+		},
+		"semverPatch": func(version string) (int, error) {
+			v, err := s.Parse(version)
+			if err != nil {
+				return 0, errors.Trace(err)
+			}
+			return v.Patch, nil
+		},
+		"semverPrerelease": func(version string) (string, error) {
+			v, err := s.Parse(version)
+			if err != nil {
+				return "", errors.Trace(err)
+			}
+			return v.Prerelease, nil
+		},
+		"semverPromote": s.Promote,
+	}
+}
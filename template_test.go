@@ -0,0 +1,66 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestFuncMap(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		template string
+		expected string
+	}{
+		{`{{ semverValid .Version }}`, "true"},
+		{`{{ semverValid "1.2" }}`, "false"},
+		{`{{ .Version | semverBump "minor" }}`, "1.3.0"},
+		{`{{ semverBump "major" .Version }}`, "2.0.0"},
+		{`{{ .Version | semverSatisfies "^1.0.0" }}`, "false"},
+		{`{{ .Version | semverSatisfies ">=1.2.3-0" }}`, "true"},
+		{`{{ semverCompare .Version "1.10.0" }}`, "-1"},
+		{`{{ semverMajor .Version }}.{{ semverMinor .Version }}.{{ semverPatch .Version }}`, "1.2.3"},
+		{`{{ semverPrerelease .Version }}`, "rc.1"},
+		{`{{ semverPromote .Version }}`, "1.2.3"},
+		{`{{ if semverSatisfies ">=1.2.0" .Version }}new{{ else }}old{{ end }}`, "old"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.template, func(t2 *testing.T) {
+			tmpl, err := template.New("test").Funcs(s.FuncMap()).Parse(c.template)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			var b strings.Builder
+			if err := tmpl.Execute(&b, map[string]string{"Version": "1.2.3-rc.1+build.5"}); err != nil {
+				t2.Fatal(err)
+			}
+			if b.String() != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, b.String())
+			}
+		})
+	}
+}
+
+func TestFuncMapErrors(t *testing.T) {
+	s := semver.MustNew()
+	for _, text := range []string{
+		`{{ semverBump "huge" "1.2.3" }}`,
+		`{{ semverBump "minor" "1.2" }}`,
+		`{{ semverSatisfies "^1.a" "1.2.3" }}`,
+		`{{ semverSatisfies "^1.0.0" "1.2" }}`,
+		`{{ semverMajor "1.2" }}`,
+		`{{ semverMinor "1.2" }}`,
+		`{{ semverPatch "1.2" }}`,
+		`{{ semverPrerelease "1.2" }}`,
+		`{{ semverPromote "1.2.3" }}`,
+		`{{ semverCompare "1.2.3" "1.2" }}`,
+	} {
+		tmpl := template.Must(template.New("test").Funcs(s.FuncMap()).Parse(text))
+		if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+			t.Fatalf("expected `%s` to fail", text)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// TenantRegistry maps tenants (or plans) to the minimum client version they support.
+// It's safe for concurrent use.
+type TenantRegistry struct {
+	semver   *Semver
+	mutex    sync.RWMutex
+	minimums map[string]string
+}
+
+// NewTenantRegistry returns a new, empty instance of TenantRegistry.
+func NewTenantRegistry(semver *Semver) *TenantRegistry {
+	return &TenantRegistry{
+		semver:   semver,
+		minimums: map[string]string{},
+	}
+}
+
+// SetMinimum sets the minimum supported version for the tenant, replacing any existing one.
+func (r *TenantRegistry) SetMinimum(tenant string, minimum string) error {
+	if !r.semver.Valid(minimum) {
+		return errors.Errorf("minimum `%s` is invalid", minimum)
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.minimums[tenant] = minimum
+	return nil
+}
+
+// Minimum returns the minimum supported version for the tenant, if any is set.
+func (r *TenantRegistry) Minimum(tenant string) (string, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	minimum, ok := r.minimums[tenant]
+	return minimum, ok
+}
+
+// Tenants returns all registered tenants in alphabetical order.
+func (r *TenantRegistry) Tenants() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	tenants := make([]string, 0, len(r.minimums))
+	for tenant := range r.minimums {
+		tenants = append(tenants, tenant)
+	}
+	sort.Strings(tenants)
+	return tenants
+}
+
+// AllowedFor checks if the version is greater than or equal to the tenant's minimum version.
+func (r *TenantRegistry) AllowedFor(tenant string, version string) (bool, error) {
+	minimum, ok := r.Minimum(tenant)
+	if !ok {
+		return false, errors.NotFoundf("tenant `%s`", tenant)
+	}
+	c, err := r.semver.compare(version, minimum)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return c >= 0, nil
+}
+
+// RaiseMinimum raises the tenant's minimum version and re-evaluates the given versions against it,
+// returning the ones that are no longer allowed. Lowering the minimum is refused; use
+// SetMinimum for that.
+func (r *TenantRegistry) RaiseMinimum(tenant string, minimum string, versions []string) ([]string, error) {
+	if !r.semver.Valid(minimum) {
+		return nil, errors.Errorf("minimum `%s` is invalid", minimum)
+	}
+	rejected := []string{}
+	for k := range versions {
+		c, err := r.semver.compare(versions[k], minimum)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if c < 0 {
+			rejected = append(rejected, versions[k])
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if current, ok := r.minimums[tenant]; ok {
+		c, err := r.semver.compare(minimum, current)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if c < 0 {
+			return nil, errors.Errorf("minimum `%s` is lower than the current minimum `%s`", minimum, current)
+		}
+	}
+	r.minimums[tenant] = minimum
+	return rejected, nil
+}
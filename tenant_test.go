@@ -0,0 +1,88 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func newTenantRegistry(t *testing.T) *semver.TenantRegistry {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := semver.NewTenantRegistry(s)
+	if err := registry.SetMinimum("free", "1.2.0"); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.SetMinimum("enterprise", "0.9.0"); err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+func TestTenantRegistryAllowedFor(t *testing.T) {
+	registry := newTenantRegistry(t)
+	cases := []struct {
+		tenant  string
+		version string
+		allowed bool
+	}{
+		{"free", "1.2.0", true},
+		{"free", "1.3.1", true},
+		{"free", "1.1.9", false},
+		{"free", "1.2.0-rc.1", false},
+		{"enterprise", "0.9.5", true},
+		{"enterprise", "0.8.0", false},
+	}
+	for k := range cases {
+		c := cases[k]
+		allowed, err := registry.AllowedFor(c.tenant, c.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if allowed != c.allowed {
+			t.Fatalf("expected `%s` allowed for `%s` to be %t", c.version, c.tenant, c.allowed)
+		}
+	}
+	if tenants := registry.Tenants(); len(tenants) != 2 || tenants[0] != "enterprise" || tenants[1] != "free" {
+		t.Fatalf("unexpected tenants %v", tenants)
+	}
+}
+
+func TestTenantRegistryErrors(t *testing.T) {
+	registry := newTenantRegistry(t)
+	if _, err := registry.AllowedFor("unknown", "1.0.0"); !errors.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+	if _, err := registry.AllowedFor("free", invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if err := registry.SetMinimum("free", invalidVersions[0]); err == nil {
+		t.Fatal("expected an error for an invalid minimum")
+	}
+	if _, err := registry.RaiseMinimum("free", "1.0.0", nil); err == nil {
+		t.Fatal("expected an error when lowering the minimum")
+	}
+}
+
+func TestTenantRegistryRaiseMinimum(t *testing.T) {
+	registry := newTenantRegistry(t)
+	rejected, err := registry.RaiseMinimum("free", "1.4.0", []string{"1.2.0", "1.4.0", "1.3.9", "2.0.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rejected) != 2 || rejected[0] != "1.2.0" || rejected[1] != "1.3.9" {
+		t.Fatalf("unexpected rejected versions %v", rejected)
+	}
+	if minimum, _ := registry.Minimum("free"); minimum != "1.4.0" {
+		t.Fatalf("expected the minimum to be raised to `1.4.0`, got `%s`", minimum)
+	}
+	if _, err := registry.RaiseMinimum("free", "1.5.0", []string{"1.5.0", invalidVersions[0]}); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if minimum, _ := registry.Minimum("free"); minimum != "1.4.0" {
+		t.Fatalf("expected the minimum to stay `1.4.0` after an invalid version, got `%s`", minimum)
+	}
+}
@@ -0,0 +1,64 @@
+package semver
+
+import (
+	"encoding"
+
+	"github.com/juju/errors"
+)
+
+var (
+	_ encoding.TextUnmarshaler = &VersionText{}
+	_ encoding.TextMarshaler   = VersionText{}
+)
+
+// MarshalText implements encoding.TextMarshaler, so a Version can be used with flag.TextVar,
+// YAML and JSON encoders and the like.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.canonical()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text must be a valid semver version. Use
+// VersionText to decode versions with an epoch or a fourth part.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := defaultSemver.Parse(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	*v = *parsed
+	return nil
+}
+
+// VersionText holds a version in text config, like JSON or a flag.TextVar, that's decoded with
+// the options of a Semver, so versions with an epoch like `2:1.4.0` or a fourth part like `1.2.3.4`
+// round-trip when it has WithEpoch or WithQuadSegments. The zero value decodes like Version does.
+type VersionText struct {
+	Version *Version
+	semver  *Semver
+}
+
+// NewVersionText returns a VersionText that decodes with semver.
+func NewVersionText(semver *Semver) VersionText {
+	return VersionText{semver: semver}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text must be a version that's valid for the Semver.
+func (t *VersionText) UnmarshalText(text []byte) error {
+	s := t.semver
+	if s == nil {
+		s = defaultSemver
+	}
+	v, err := s.Parse(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	t.Version = v
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler and returns the canonical notation. An unset version fails.
+func (t VersionText) MarshalText() ([]byte, error) {
+	if t.Version == nil {
+		return nil, errors.New("version is unset")
+	}
+	return t.Version.MarshalText()
+}
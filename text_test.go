@@ -0,0 +1,78 @@
+package semver_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var (
+	_ encoding.TextMarshaler   = semver.Version{}
+	_ encoding.TextUnmarshaler = &semver.Version{}
+)
+
+func TestVersionText(t *testing.T) {
+	var v semver.Version
+	if err := v.UnmarshalText([]byte("1.2.3-rc.1+build.5")); err != nil {
+		t.Fatal(err)
+	}
+	if v.Major != 1 || v.Minor != 2 || v.Patch != 3 || v.Prerelease != "rc.1" || v.Build != "build.5" {
+		t.Fatalf("unexpected unmarshalled version %+v", v)
+	}
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(text) != "1.2.3-rc.1+build.5" {
+		t.Fatalf("unexpected marshalled text `%s`", text)
+	}
+	if err := v.UnmarshalText([]byte(invalidVersions[0])); err == nil {
+		t.Fatal("expected an error unmarshalling an invalid version")
+	}
+	text, err = semver.Version{Major: 2, Prerelease: "beta"}.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(text) != "2.0.0-beta" {
+		t.Fatalf("expected a hand-built version to marshal from its parts, got `%s`", text)
+	}
+}
+
+func TestVersionJSON(t *testing.T) {
+	type config struct {
+		Client  semver.Version  `json:"client"`
+		Minimum *semver.Version `json:"minimum"`
+	}
+	var c config
+	if err := json.Unmarshal([]byte(`{"client":"1.4.0","minimum":"1.2.0-rc.1"}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Client.Minor != 4 || c.Minimum == nil || c.Minimum.Prerelease != "rc.1" {
+		t.Fatalf("unexpected decoded config %+v", c)
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"client":"1.4.0","minimum":"1.2.0-rc.1"}` {
+		t.Fatalf("unexpected encoded config %s", data)
+	}
+	if err := json.Unmarshal([]byte(`{"client":"1.4"}`), &c); err == nil {
+		t.Fatal("expected an error decoding an invalid version")
+	}
+}
+
+func TestVersionTextUnset(t *testing.T) {
+	var text semver.VersionText
+	if _, err := text.MarshalText(); err == nil {
+		t.Fatal("expected an error marshalling an unset version")
+	}
+	if err := text.UnmarshalText([]byte("1.2.3")); err != nil || text.Version.Patch != 3 {
+		t.Fatalf("expected the zero value to decode `1.2.3`, got %+v, %v", text.Version, err)
+	}
+	if err := text.UnmarshalText([]byte("1:1.2.3")); err == nil {
+		t.Fatal("expected the zero value not to decode epochs")
+	}
+}
@@ -0,0 +1,60 @@
+package semver
+
+import (
+	"encoding"
+
+	"github.com/juju/errors"
+)
+
+var (
+	_ encoding.TextUnmarshaler = &ConstraintTOML{}
+	_ encoding.TextMarshaler   = ConstraintTOML{}
+)
+
+// ConstraintTOML holds a constraint in TOML config, like the dependencies of a Cargo-like manifest. TOML
+// libraries like BurntSushi/toml and pelletier/go-toml decode through encoding.TextUnmarshaler, which
+// Version implements as well. The constraint is parsed while decoding, so an invalid expression fails at
+// startup instead of on the first comparison. The zero value parses by the semver 2.0.0 spec; use
+// NewConstraintTOML to parse with the options of a Semver. Map values decode into new zero values.
+type ConstraintTOML struct {
+	Constraint *Constraint
+	// Validate is called with the parsed constraint while decoding, when set. Set it on the defaults
+	// the config decodes into to add checks of your own, like requiring an upper bound.
+	Validate func(c *Constraint) error
+
+	semver *Semver
+}
+
+// NewConstraintTOML returns a ConstraintTOML that parses with semver. Set it on the defaults the config
+// decodes into.
+func NewConstraintTOML(semver *Semver) ConstraintTOML {
+	return ConstraintTOML{semver: semver}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text should be a constraint expression.
+func (c *ConstraintTOML) UnmarshalText(text []byte) error {
+	s := c.semver
+	if s == nil {
+		s = defaultSemver
+	}
+	constraint, err := s.ParseConstraint(string(text))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if c.Validate != nil {
+		if err := validateConstraint(c.Validate, constraint); err != nil {
+			return errors.Annotatef(err, "constraint `%s`", text)
+		}
+	}
+	c.Constraint = constraint
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler and returns the normalized expression. Since TOML has no
+// null, an unset constraint fails.
+func (c ConstraintTOML) MarshalText() ([]byte, error) {
+	if c.Constraint == nil {
+		return nil, errors.New("constraint is unset")
+	}
+	return []byte(c.Constraint.String()), nil
+}
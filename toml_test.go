@@ -0,0 +1,95 @@
+package semver_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+type tomlConfig struct {
+	Version      semver.Version                   `toml:"version"`
+	Dependencies map[string]semver.ConstraintTOML `toml:"dependencies"`
+}
+
+func TestConstraintTOML(t *testing.T) {
+	input := "version = \"1.4.2-rc.1\"\n\n[dependencies]\nserde = \">=1.0.0, <2.0.0\"\ntokio = \"^1.28.0\"\n"
+	var config tomlConfig
+	if _, err := toml.Decode(input, &config); err != nil {
+		t.Fatal(err)
+	}
+	if config.Version.Original() != "1.4.2-rc.1" {
+		t.Fatalf("expected version `1.4.2-rc.1`, got `%s`", config.Version.Original())
+	}
+	ok, err := config.Dependencies["serde"].Constraint.Check("1.0.188")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected `1.0.188` to satisfy the decoded constraint")
+	}
+	var b bytes.Buffer
+	if err := toml.NewEncoder(&b).Encode(config); err != nil {
+		t.Fatal(err)
+	}
+	expected := "version = \"1.4.2-rc.1\"\n\n[dependencies]\n  serde = \">=1.0.0 <2.0.0\"\n  tokio = \"^1.28.0\"\n"
+	if b.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, b.String())
+	}
+	if _, err := (semver.ConstraintTOML{}).MarshalText(); err == nil {
+		t.Fatal("expected an error marshalling an unset constraint")
+	}
+}
+
+func TestConstraintTOMLErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{"invalid constraint", "[dependencies]\nserde = \"^1.a\"\n"},
+		{"invalid version", "version = \"1.4\"\n"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			var config tomlConfig
+			if _, err := toml.Decode(c.input, &config); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestNewConstraintTOML(t *testing.T) {
+	config := struct {
+		Requires semver.ConstraintTOML `toml:"requires"`
+	}{Requires: semver.NewConstraintTOML(semver.MustNew(semver.WithQuadSegments()))}
+	if _, err := toml.Decode("requires = \"^1.2.3.4\"\n", &config); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := config.Requires.Constraint.Check("1.2.3.5"); err != nil || !ok {
+		t.Fatalf("expected `1.2.3.5` to satisfy the decoded constraint, got %v, %v", ok, err)
+	}
+	var plain semver.ConstraintTOML
+	if err := plain.UnmarshalText([]byte("^1.2.3.4")); err == nil {
+		t.Fatal("expected the zero value to reject a fourth part")
+	}
+}
+
+func TestConstraintTOMLValidate(t *testing.T) {
+	errUnbounded := errors.New("constraint should have an upper bound")
+	c := semver.ConstraintTOML{Validate: func(c *semver.Constraint) error {
+		if _, _, max, _, _ := c.Bounds(); max == nil {
+			return errUnbounded
+		}
+		return nil
+	}}
+	if err := c.UnmarshalText([]byte(">=1.0.0")); errors.Cause(err) != errUnbounded {
+		t.Fatalf("expected the validation error, got %v", err)
+	}
+	if err := c.UnmarshalText([]byte("^1.0.0")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,77 @@
+package semver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+var reBranchPlaceholder = regexp.MustCompile(`\{[^}]*\}`)
+
+// ReleaseTrain maps versions to the release branch of their series, so CI can route backports.
+type ReleaseTrain struct {
+	semver   *Semver
+	template string
+	level    Level
+}
+
+// NewReleaseTrain returns a ReleaseTrain that maps versions to series at the level, like `1.4.x` for
+// LevelMinor, and to branches by the template. The template can hold the `{major}`, `{minor}` and
+// `{patch}` placeholders, up to the level, like `release/{major}.{minor}`.
+func NewReleaseTrain(semver *Semver, template string, level Level) (*ReleaseTrain, error) {
+	if level < LevelMajor || level > LevelPatch {
+		return nil, errors.Errorf("level `%s` is invalid", level)
+	}
+	for _, placeholder := range reBranchPlaceholder.FindAllString(template, -1) {
+		name := placeholder[1 : len(placeholder)-1]
+		var found bool
+		for k := LevelMajor; k <= level; k++ {
+			if name == k.String() {
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("placeholder `%s` can't be used for the %s level", placeholder, level)
+		}
+	}
+	return &ReleaseTrain{semver: semver, template: template, level: level}, nil
+}
+
+// SeriesOf returns the series of the version, like `1.4.x` for `1.4.3-rc.1` at LevelMinor.
+func (t *ReleaseTrain) SeriesOf(version string) (string, error) {
+	v, err := t.semver.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	parts := t.parts(v)
+	series := strings.Join(parts, ".")
+	if t.level < LevelPatch {
+		series += ".x"
+	}
+	return series, nil
+}
+
+// BranchFor returns the release branch of the version, like `release/1.4` for `1.4.3`.
+func (t *ReleaseTrain) BranchFor(version string) (string, error) {
+	v, err := t.semver.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	parts := t.parts(v)
+	branch := reBranchPlaceholder.ReplaceAllStringFunc(t.template, func(placeholder string) string {
+		for k := range parts {
+			if placeholder[1:len(placeholder)-1] == Level(k).String() {
+				return parts[k]
+			}
+		}
+		return placeholder
+	})
+	return branch, nil
+}
+
+func (t *ReleaseTrain) parts(v *Version) []string {
+	parts := []string{strconv.Itoa(v.Major), strconv.Itoa(v.Minor), strconv.Itoa(v.Patch)}
+	return parts[:t.level+1]
+}
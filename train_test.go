@@ -0,0 +1,78 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+func TestReleaseTrain(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		template string
+		level    semver.Level
+		version  string
+		series   string
+		branch   string
+	}{
+		{"release/{major}.{minor}", semver.LevelMinor, "1.4.3", "1.4.x", "release/1.4"},
+		{"release/{major}.{minor}", semver.LevelMinor, "1.4.0-rc.1+build", "1.4.x", "release/1.4"},
+		{"v{major}", semver.LevelMajor, "2.7.1", "2.x", "v2"},
+		{"hotfix/{major}.{minor}.{patch}", semver.LevelPatch, "1.4.3", "1.4.3", "hotfix/1.4.3"},
+		{"stable", semver.LevelMinor, "1.4.3", "1.4.x", "stable"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.template+" "+c.version, func(t2 *testing.T) {
+			train, err := semver.NewReleaseTrain(s, c.template, c.level)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			series, err := train.SeriesOf(c.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if series != c.series {
+				t2.Fatalf("expected series `%s`, got `%s`", c.series, series)
+			}
+			branch, err := train.BranchFor(c.version)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if branch != c.branch {
+				t2.Fatalf("expected branch `%s`, got `%s`", c.branch, branch)
+			}
+		})
+	}
+}
+
+func TestReleaseTrainErrors(t *testing.T) {
+	s := semver.MustNew()
+	cases := []struct {
+		name     string
+		template string
+		level    semver.Level
+	}{
+		{"level", "release/{major}", semver.Level(5)},
+		{"placeholder below level", "release/{major}.{minor}", semver.LevelMajor},
+		{"unknown placeholder", "release/{series}", semver.LevelMinor},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			if _, err := semver.NewReleaseTrain(s, c.template, c.level); err == nil {
+				t2.Fatal("expected an error")
+			}
+		})
+	}
+	train, err := semver.NewReleaseTrain(s, "release/{major}.{minor}", semver.LevelMinor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := train.BranchFor("1.4"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	if _, err := train.SeriesOf("1.4"); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
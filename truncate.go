@@ -0,0 +1,47 @@
+package semver
+
+import (
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// Level is the granularity a version is truncated to.
+type Level int
+
+// The levels from coarsest to finest.
+const (
+	LevelMajor Level = iota
+	LevelMinor
+	LevelPatch
+)
+
+var levelNames = []string{"major", "minor", "patch"}
+
+func (l Level) String() string {
+	if l < LevelMajor || l > LevelPatch {
+		return "unknown"
+	}
+	return levelNames[l]
+}
+
+// Truncate reduces the version to the parts up to the level, so `1.2.3-rc.1+build.5` becomes `1`
+// for LevelMajor, `1.2` for LevelMinor and `1.2.3` for LevelPatch. The prerelease and build
+// metadata are always dropped.
+func (s *Semver) Truncate(version string, level Level) (string, error) {
+	if level < LevelMajor || level > LevelPatch {
+		return "", errors.Errorf("level `%d` is invalid", level)
+	}
+	v, err := s.Parse(version)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	truncated := strconv.Itoa(v.Major)
+	if level >= LevelMinor {
+		truncated += "." + strconv.Itoa(v.Minor)
+	}
+	if level >= LevelPatch {
+		truncated += "." + strconv.Itoa(v.Patch)
+	}
+	return truncated, nil
+}
@@ -0,0 +1,58 @@
+package semver_test
+
+import (
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+)
+
+var truncateVersions = []struct {
+	version  string
+	level    semver.Level
+	expected string
+}{
+	{"1.2.3", semver.LevelMajor, "1"},
+	{"1.2.3", semver.LevelMinor, "1.2"},
+	{"1.2.3", semver.LevelPatch, "1.2.3"},
+	{"1.2.3-rc.1+build.5", semver.LevelMajor, "1"},
+	{"1.2.3-rc.1+build.5", semver.LevelMinor, "1.2"},
+	{"1.2.3-rc.1+build.5", semver.LevelPatch, "1.2.3"},
+	{"0.0.0", semver.LevelMinor, "0.0"},
+}
+
+func TestTruncate(t *testing.T) {
+	for k := range truncateVersions {
+		c := truncateVersions[k]
+		t.Run("truncate-"+c.version+"_"+c.level.String(), func(t2 *testing.T) {
+			semver, err := semver.New()
+			if err != nil {
+				t2.Fatal(err)
+			}
+			truncated, err := semver.Truncate(c.version, c.level)
+			if err != nil {
+				t2.Fatal(err)
+			}
+			if truncated != c.expected {
+				t2.Fatalf("expected `%s`, got `%s`", c.expected, truncated)
+			}
+		})
+	}
+}
+
+func TestTruncateErrors(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Truncate(invalidVersions[0], semver.LevelMajor); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+	for _, level := range []semver.Level{semver.LevelMajor - 1, semver.LevelPatch + 1} {
+		if _, err := s.Truncate("1.2.3", level); err == nil {
+			t.Fatalf("expected an error for level `%d`", level)
+		}
+		if level.String() != "unknown" {
+			t.Fatalf("expected level `%d` to be unknown, got `%s`", level, level)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package semver
+
+import (
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// Version is a parsed semver version. The zero value is the `0.0.0` version and all methods can be used
+// on it, so a Version can be embedded in structs without a pointer and checked for being unset with IsZero.
+type Version struct {
+	// Epoch is only set by a Semver with the WithEpoch option.
+	Epoch int
+	Major int
+	Minor int
+	Patch int
+	// Revision is the fourth part, which is only set by a Semver with the WithQuadSegments option.
+	Revision   int
+	Prerelease string
+	Build      string
+
+	original string
+	quad     bool
+	sentinel int
+	channels *channelOrder
+
+	maxSegment  int
+	strictBuild bool
+}
+
+// Parse validates and parses the given version. Versions with a part that doesn't fit in an int
+// fail with an *OverflowError as cause.
+func (s *Semver) Parse(version string) (*Version, error) {
+	v, err := s.parseInput(version)
+	if err != nil {
+		s.logFailure("parse", version, err)
+		s.validated(false)
+		return nil, errors.Trace(err)
+	}
+	s.validated(true)
+	return v, nil
+}
+
+// parseInput parses a version that's passed in, see input.
+func (s *Semver) parseInput(version string) (*Version, error) {
+	converted, err := s.input(version)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	v, err := s.parse(converted)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if s.grammar != nil {
+		v.original = version
+	}
+	return v, nil
+}
+
+func (s *Semver) parse(version string) (*Version, error) {
+	if s.schemeVersioning != nil {
+		return nil, errors.Trace(s.notSupported())
+	}
+	if s.tooLong(version) {
+		return nil, errors.Trace(s.lengthError(version))
+	}
+	if !s.validSyntax(version) {
+		if err := s.checkLeadingZeros(version); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return nil, errors.Errorf("version `%s` is invalid", version)
+	}
+	semVersion, err := s.buildVersion(version)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &Version{
+		Epoch:       semVersion.epoch,
+		Major:       semVersion.major,
+		Minor:       semVersion.minor,
+		Patch:       semVersion.revision,
+		Revision:    semVersion.fourth,
+		quad:        semVersion.quad,
+		Prerelease:  semVersion.tag,
+		Build:       semVersion.build,
+		original:    version,
+		channels:    semVersion.channels,
+		maxSegment:  semVersion.maxSegment,
+		strictBuild: semVersion.strictBuild,
+	}, nil
+}
+
+// Original returns the string the version was parsed from. For versions that weren't parsed, like
+// the zero value, it returns the canonical notation.
+func (v *Version) Original() string {
+	if v.original == "" {
+		return v.canonical()
+	}
+	return v.original
+}
+
+// IsZero checks if the version is the zero value `0.0.0`, without a prerelease or build metadata.
+// Parsed `0.0.0` versions and Zero are zero as well.
+func (v Version) IsZero() bool {
+	return v.sentinel == 0 && v.Epoch == 0 && v.Major == 0 && v.Minor == 0 && v.Patch == 0 && v.Revision == 0 &&
+		v.Prerelease == "" && v.Build == ""
+}
+
+// Compare compares the version to o by the semver 2.0.0 precedence rules.
+// It returns -1 when v is lower than o, 1 when it's higher and 0 when they're equal.
+// The Max sentinel is always the highest.
+func (v *Version) Compare(o *Version) int {
+	if v.sentinel != 0 || o.sentinel != 0 {
+		return compareInts(v.sentinel, o.sentinel)
+	}
+	return compareSemVersions(v.semVersion(), o.semVersion())
+}
+
+func (v *Version) semVersion() *semVersion {
+	return &semVersion{
+		epoch:       v.Epoch,
+		major:       v.Major,
+		minor:       v.Minor,
+		revision:    v.Patch,
+		fourth:      v.Revision,
+		quad:        v.quad,
+		tag:         v.Prerelease,
+		build:       v.Build,
+		channels:    v.channels,
+		strictBuild: v.strictBuild,
+	}
+}
+
+// canonical builds the semver notation from the version's parts.
+func (v *Version) canonical() string {
+	version := strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor) + "." + strconv.Itoa(v.Patch)
+	if v.quad || v.Revision != 0 {
+		version += "." + strconv.Itoa(v.Revision)
+	}
+	if v.Epoch != 0 {
+		version = strconv.Itoa(v.Epoch) + ":" + version
+	}
+	if v.Prerelease != "" {
+		version += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		version += "+" + v.Build
+	}
+	return version
+}
+
+// core returns a copy of the version without prerelease and build metadata.
+func (v *Version) core() *Version {
+	return &Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Patch: v.Patch, Revision: v.Revision, quad: v.quad,
+		maxSegment: v.maxSegment, strictBuild: v.strictBuild}
+}
@@ -0,0 +1,154 @@
+package semver_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+)
+
+func TestParse(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := semver.Parse("1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Major != 1 || v.Minor != 2 || v.Patch != 3 || v.Prerelease != "rc.1" || v.Build != "build.5" {
+		t.Fatalf("unexpected parsed version %+v", v)
+	}
+	if v.Original() != "1.2.3-rc.1+build.5" {
+		t.Fatalf("unexpected original `%s`", v.Original())
+	}
+	for k := range invalidVersions {
+		if _, err := semver.Parse(invalidVersions[k]); err == nil {
+			t.Fatalf("expected an error parsing `%s`", invalidVersions[k])
+		}
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	semver, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"2.0.0",
+	}
+	for k := 1; k < len(ordered); k++ {
+		lower, err := semver.Parse(ordered[k-1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		higher, err := semver.Parse(ordered[k])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lower.Compare(higher) != -1 || higher.Compare(lower) != 1 || lower.Compare(lower) != 0 {
+			t.Fatalf("expected `%s` to be lower than `%s`", ordered[k-1], ordered[k])
+		}
+	}
+}
+
+func TestParseOverflow(t *testing.T) {
+	s, err := semver.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxInt := strconv.Itoa(int(^uint(0) >> 1))
+	for _, version := range []string{maxInt + ".0.0", "0." + maxInt + ".0", "0.0." + maxInt + "-rc.1"} {
+		if _, err := s.Parse(version); err != nil {
+			t.Fatalf("expected `%s` at the int boundary to parse: %v", version, err)
+		}
+	}
+	overflowing := addOne(maxInt)
+	cases := []struct {
+		version string
+		part    string
+	}{
+		{overflowing + ".0.0", "major"},
+		{"0." + overflowing + ".0", "minor"},
+		{"0.0." + overflowing + "+build", "patch"},
+		{"99999999999999999999.0.0", "major"},
+	}
+	for k := range cases {
+		c := cases[k]
+		if s.Valid(c.version) {
+			t.Fatalf("expected `%s` to be invalid", c.version)
+		}
+		_, err := s.Parse(c.version)
+		overflowErr, ok := errors.Cause(err).(*semver.OverflowError)
+		if !ok {
+			t.Fatalf("expected an *OverflowError for `%s`, got %v", c.version, err)
+		}
+		if overflowErr.Part != c.part || overflowErr.Version != c.version {
+			t.Fatalf("unexpected overflow error %+v", overflowErr)
+		}
+	}
+}
+
+// addOne increments a decimal string without converting it, so it can go beyond the int boundary.
+func addOne(n string) string {
+	digits := []byte(n)
+	for k := len(digits) - 1; k >= 0; k-- {
+		if digits[k] != '9' {
+			digits[k]++
+			return string(digits)
+		}
+		digits[k] = '0'
+	}
+	return "1" + string(digits)
+}
+
+func TestVersionZeroValue(t *testing.T) {
+	s := semver.MustNew()
+	type release struct {
+		Name    string
+		Version semver.Version
+	}
+	var unset release
+	if !unset.Version.IsZero() {
+		t.Fatal("expected an unset version to be zero")
+	}
+	if result := unset.Version.String(); result != "0.0.0" {
+		t.Fatalf("expected the zero value to be `0.0.0`, got `%s`", result)
+	}
+	if result := unset.Version.Original(); result != "0.0.0" {
+		t.Fatalf("expected the original of the zero value to be `0.0.0`, got `%s`", result)
+	}
+	if result := unset.Version.Format("M.m"); result != "0.0" {
+		t.Fatalf("expected the zero value to format as `0.0`, got `%s`", result)
+	}
+	parsed := s.MustParse("0.0.0")
+	if !parsed.IsZero() || unset.Version.Compare(parsed) != 0 || unset.Version.Hash() != parsed.Hash() {
+		t.Fatal("expected the zero value to equal a parsed `0.0.0`")
+	}
+	if unset.Version.CompareCore(s.MustParse("0.0.1")) != -1 {
+		t.Fatal("expected the zero value to be lower than `0.0.1`")
+	}
+	text, err := unset.Version.MarshalText()
+	if err != nil || string(text) != "0.0.0" {
+		t.Fatalf("expected the zero value to marshal as `0.0.0`, got `%s` (%v)", text, err)
+	}
+	for _, version := range []string{"0.0.0-rc.1", "0.0.0+build", "0.0.1"} {
+		if s.MustParse(version).IsZero() {
+			t.Fatalf("expected `%s` not to be zero", version)
+		}
+	}
+	if zero, max := semver.Zero(), semver.Max(); !zero.IsZero() || zero.Compare(&semver.Version{}) != 0 || max.IsZero() {
+		t.Fatal("expected Zero to be the zero value and Max not to be zero")
+	}
+}
@@ -0,0 +1,65 @@
+package semver
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ yaml.Unmarshaler = &ConstraintYAML{}
+	_ yaml.Marshaler   = ConstraintYAML{}
+)
+
+// ConstraintYAML holds a constraint in YAML config. The constraint is parsed while decoding, so an
+// invalid expression fails at startup instead of on the first comparison. The zero value parses by
+// the semver 2.0.0 spec; use NewConstraintYAML to parse with the options of a Semver.
+type ConstraintYAML struct {
+	Constraint *Constraint
+	// Validate is called with the parsed constraint while decoding, when set. Set it on the defaults
+	// the config decodes into to add checks of your own, like requiring an upper bound.
+	Validate func(c *Constraint) error
+
+	semver *Semver
+}
+
+// NewConstraintYAML returns a ConstraintYAML that parses with semver. Set it on the defaults the config
+// decodes into.
+func NewConstraintYAML(semver *Semver) ConstraintYAML {
+	return ConstraintYAML{semver: semver}
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. The node should be a scalar holding a constraint expression.
+func (c *ConstraintYAML) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return errors.Errorf("line %d: constraint should be a string", value.Line)
+	}
+	s := c.semver
+	if s == nil {
+		s = defaultSemver
+	}
+	constraint, err := s.ParseConstraint(value.Value)
+	if err != nil {
+		return errors.Annotatef(err, "line %d", value.Line)
+	}
+	if c.Validate != nil {
+		if err := validateConstraint(c.Validate, constraint); err != nil {
+			return errors.Annotatef(err, "line %d: constraint `%s`", value.Line, value.Value)
+		}
+	}
+	c.Constraint = constraint
+	return nil
+}
+
+// validateConstraint calls the user-provided validate func of a config type.
+func validateConstraint(validate func(c *Constraint) error, constraint *Constraint) (err error) {
+	defer recoverHook("validate", &err)
+	return validate(constraint)
+}
+
+// MarshalYAML implements yaml.Marshaler and returns the normalized expression.
+func (c ConstraintYAML) MarshalYAML() (interface{}, error) {
+	if c.Constraint == nil {
+		return nil, nil
+	}
+	return c.Constraint.String(), nil
+}
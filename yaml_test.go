@@ -0,0 +1,96 @@
+package semver_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/espal-digital-development/semver"
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v3"
+)
+
+type yamlConfig struct {
+	Requires semver.ConstraintYAML `yaml:"requires"`
+}
+
+func TestConstraintYAML(t *testing.T) {
+	var config yamlConfig
+	if err := yaml.Unmarshal([]byte("requires: '>=1.2.0, <2.0.0'\n"), &config); err != nil {
+		t.Fatal(err)
+	}
+	ok, err := config.Requires.Constraint.Check("1.5.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected `1.5.0` to satisfy the decoded constraint")
+	}
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "requires: '>=1.2.0 <2.0.0'\n" {
+		t.Fatalf("unexpected marshalled config %q", out)
+	}
+	out, err = yaml.Marshal(yamlConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "requires: null\n" {
+		t.Fatalf("unexpected marshalled empty config %q", out)
+	}
+}
+
+func TestConstraintYAMLErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{"invalid", "requires: ^1.a\n"},
+		{"sequence", "requires: [^1.2.0]\n"},
+	}
+	for k := range cases {
+		c := cases[k]
+		t.Run(c.name, func(t2 *testing.T) {
+			var config yamlConfig
+			err := yaml.Unmarshal([]byte(c.input), &config)
+			if err == nil {
+				t2.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), "line 1") {
+				t2.Fatalf("expected the error to name the line, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewConstraintYAML(t *testing.T) {
+	config := yamlConfig{Requires: semver.NewConstraintYAML(semver.MustNew(semver.WithEpoch()))}
+	if err := yaml.Unmarshal([]byte("requires: '>=1:1.2.0'\n"), &config); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := config.Requires.Constraint.Check("1:1.5.0"); err != nil || !ok {
+		t.Fatalf("expected `1:1.5.0` to satisfy the decoded constraint, got %v, %v", ok, err)
+	}
+	var plain yamlConfig
+	if err := yaml.Unmarshal([]byte("requires: '>=1:1.2.0'\n"), &plain); err == nil {
+		t.Fatal("expected the zero value to reject an epoch")
+	}
+}
+
+func TestConstraintYAMLValidate(t *testing.T) {
+	errUnbounded := errors.New("constraint should have an upper bound")
+	config := yamlConfig{Requires: semver.ConstraintYAML{Validate: func(c *semver.Constraint) error {
+		if !strings.ContainsAny(c.String(), "<^~") {
+			return errUnbounded
+		}
+		return nil
+	}}}
+	if err := yaml.Unmarshal([]byte("requires: ^1.2.0\n"), &config); err != nil {
+		t.Fatal(err)
+	}
+	err := yaml.Unmarshal([]byte("requires: '>=1.2.0'\n"), &config)
+	if errors.Cause(err) != errUnbounded {
+		t.Fatalf("expected the validation error as cause, got %v", err)
+	}
+}